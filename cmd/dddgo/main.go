@@ -0,0 +1,555 @@
+// Command dddgo validates the DDD stereotypes of a Go project.
+//
+// Usage:
+//
+//	dddgo [flags] [path]
+//	dddgo rules
+//	dddgo scaffold [-write] [path]
+//
+// The path defaults to the current directory.
+// The rules subcommand lists every check with its stereotypes, default severity and description.
+// The scaffold subcommand prints a constructor stub for every stereotype type without a constructor,
+// -write appends the stubs to the files declaring the types.
+//
+// Exit codes:
+//   - 0: No violation at or above the minimum severity was found
+//   - 1: Violations were found
+//   - 2: Invalid command line arguments or configuration file
+//   - 3: The project could not be scanned
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	"github.com/nobuenhombre/dddgo/pkg/layers"
+	"github.com/nobuenhombre/dddgo/pkg/reporter"
+	"github.com/nobuenhombre/dddgo/pkg/validator"
+)
+
+// Exit codes of the command, stable for use in scripts.
+const (
+	ExitClean      = 0
+	ExitViolations = 1
+	ExitUsage      = 2
+	ExitScanFailed = 3
+)
+
+// layersReport keys the report of the layer checks among the stereotype reports.
+const layersReport = "Layers"
+
+const (
+	colorAuto   = "auto"
+	colorAlways = "always"
+	colorNever  = "never"
+)
+
+// ANSI escape sequences coloring text output by severity.
+const (
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiReset  = "\x1b[0m"
+)
+
+const (
+	formatText    = "text"
+	formatJUnit   = "junit"
+	formatJSON    = "json"
+	formatSummary = "summary"
+	formatNDJSON  = "ndjson"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command and returns its exit code.
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) > 0 && args[0] == "rules" {
+		return runRules(args[1:], stdout, stderr)
+	}
+
+	if len(args) > 0 && args[0] == "scaffold" {
+		return runScaffold(args[1:], stdout, stderr)
+	}
+
+	flags := flag.NewFlagSet("dddgo", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	minSeverity := flags.String("min-severity", "", "lowest severity that fails the run: info, warning or error")
+	enable := flags.String("enable", "", "comma separated opt-in checks to run")
+	format := flags.String("format", formatText, "output format: text, junit, json, ndjson or summary")
+	color := flags.String("color", colorAuto, "color text output by severity: auto, always or never")
+	metrics := flags.Bool("metrics", false, "print per-phase timings to stderr")
+	baseline := flags.String("baseline", "", "baseline file of accepted violations to suppress")
+	writeBaseline := flags.String("write-baseline", "", "write the current violations to a baseline file and exit")
+	warningsAsErrors := flags.Bool("warnings-as-errors", false, "report warnings as errors, failing the run")
+	exportedOnly := flags.Bool("exported-only", false, "validate exported stereotype types only")
+	interfaceFactories := flags.Bool("interface-factories", false, "treat New functions returning interfaces as constructors of the types they return")
+	maxValueObjectFields := flags.Int("max-value-object-fields", 0, "report value objects with more data fields, 0 disables the limit")
+	tags := flags.String("tags", "", "comma separated build tags; when set, files excluded by their build constraints are skipped")
+	suggest := flags.Bool("suggest", false, "print a suggested fix below every violation")
+	modulePaths := flags.String("module-path", "", "comma separated from=to module path remaps of the marker packages, e.g. for a vendored copy of dddgo")
+	strictScan := flags.Bool("strict-scan", false, "fail the run when files or directories cannot be read")
+	entrypoint := flags.String("entrypoint", "", "import path of a package; only it and the module packages it imports are validated")
+	typed := flags.Bool("typed", false, "recognise markers with go/types, slower but independent of import aliases")
+	domain := flags.String("domain", "", "comma separated package patterns of the domain layer; enables the layer checks, also set by the layers section of the config file")
+
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: dddgo [flags] [path]")
+		fmt.Fprintln(stderr, "       dddgo rules")
+		fmt.Fprintln(stderr, "       dddgo scaffold [-write] [path]")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return ExitUsage
+	}
+
+	if flags.NArg() > 1 || (*format != formatText && *format != formatJUnit && *format != formatJSON && *format != formatNDJSON && *format != formatSummary) ||
+		(*color != colorAuto && *color != colorAlways && *color != colorNever) {
+		flags.Usage()
+
+		return ExitUsage
+	}
+
+	rootPath := "."
+	if flags.NArg() == 1 {
+		rootPath = flags.Arg(0)
+	}
+
+	var opts []helpers.Option
+
+	if *minSeverity != "" {
+		severity, err := helpers.ParseSeverity(*minSeverity)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+
+			return ExitUsage
+		}
+
+		opts = append(opts, helpers.WithMinSeverity(severity))
+	}
+
+	if *enable != "" {
+		opts = append(opts, helpers.WithEnabledChecks(strings.Split(*enable, ",")...))
+	}
+
+	if *warningsAsErrors {
+		opts = append(opts, helpers.WithWarningsAsErrors(true))
+	}
+
+	if *exportedOnly {
+		opts = append(opts, helpers.WithExportedOnly(true))
+	}
+
+	if *interfaceFactories {
+		opts = append(opts, helpers.WithInterfaceFactories())
+	}
+
+	if *maxValueObjectFields > 0 {
+		opts = append(opts, helpers.WithMaxValueObjectFields(*maxValueObjectFields))
+	}
+
+	if flagSet(flags, "tags") {
+		var buildTags []string
+		if *tags != "" {
+			buildTags = strings.Split(*tags, ",")
+		}
+
+		opts = append(opts, helpers.WithBuildTags(buildTags...))
+	}
+
+	if *suggest {
+		opts = append(opts, helpers.WithSuggestions())
+	}
+
+	if *metrics {
+		opts = append(opts, helpers.WithMetrics())
+	}
+
+	if *typed {
+		opts = append(opts, helpers.WithTypeInfo())
+	}
+
+	if *modulePaths != "" {
+		for _, remap := range strings.Split(*modulePaths, ",") {
+			from, to, ok := strings.Cut(remap, "=")
+			if !ok || from == "" || to == "" {
+				fmt.Fprintf(stderr, "invalid module path remap %q, expected from=to\n", remap)
+
+				return ExitUsage
+			}
+
+			opts = append(opts, helpers.WithModulePath(from, to))
+		}
+	}
+
+	if *strictScan {
+		opts = append(opts, helpers.WithStrictScan(true))
+	}
+
+	if *entrypoint != "" {
+		opts = append(opts, helpers.WithEntrypoint(*entrypoint))
+	}
+
+	if *domain != "" {
+		opts = append(opts, helpers.WithDomainPackages(strings.Split(*domain, ",")...))
+	}
+
+	if *baseline != "" {
+		opts = append(opts, helpers.WithBaseline(*baseline))
+	}
+
+	// A new baseline records every current violation, so no baseline must hide them
+	if *writeBaseline != "" {
+		opts = append(opts, helpers.WithBaseline(""))
+	}
+
+	reports, err := validator.Validate(rootPath, opts...)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitCodeOf(err)
+	}
+
+	if err := validateLayers(rootPath, reports, opts); err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitCodeOf(err)
+	}
+
+	if *writeBaseline != "" {
+		if err := helpers.NewBaseline(reports).Write(*writeBaseline); err != nil {
+			fmt.Fprintln(stderr, err)
+
+			return ExitScanFailed
+		}
+
+		return ExitClean
+	}
+
+	switch *format {
+	case formatJUnit:
+		err = reporter.WriteJUnit(stdout, reports)
+	case formatJSON:
+		err = reporter.WriteJSON(stdout, reports)
+	case formatNDJSON:
+		err = reporter.WriteJSONLines(stdout, reports)
+	case formatSummary:
+		err = reporter.WriteSummary(stdout, reports)
+	default:
+		err = writeText(stdout, reports, useColor(*color, stdout))
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return ExitScanFailed
+	}
+
+	writeScanErrors(stderr, reports)
+
+	if *metrics {
+		writeMetrics(stderr, reports)
+	}
+
+	for _, report := range reports {
+		if report.Failed() {
+			return ExitViolations
+		}
+	}
+
+	return ExitClean
+}
+
+// validateLayers adds the report of the layer checks under layersReport when domain packages are configured,
+// with the -domain flag or the layers section of the config file.
+func validateLayers(rootPath string, reports map[string]*helpers.Report, opts []helpers.Option) error {
+	options, err := helpers.LoadOptions(rootPath, opts...)
+	if err != nil {
+		return err
+	}
+
+	if len(options.DomainPackages) == 0 {
+		return nil
+	}
+
+	coverage, err := layers.ValidateDomainCoverage(rootPath, layers.LayerConfig{}, opts...)
+	if err != nil {
+		return err
+	}
+
+	boundary, err := layers.ValidateBoundarySerialization(rootPath, layers.LayerConfig{}, opts...)
+	if err != nil {
+		return err
+	}
+
+	reports[layersReport] = helpers.MergeReports(coverage, boundary)
+
+	return nil
+}
+
+// runRules prints every check as a table of identifier, stereotypes, default severity, opt-in and description.
+func runRules(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) > 0 {
+		fmt.Fprintln(stderr, "Usage: dddgo rules")
+
+		return ExitUsage
+	}
+
+	table := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "ID\tSTEREOTYPES\tSEVERITY\tOPT-IN\tDESCRIPTION")
+
+	for _, check := range helpers.ListChecks() {
+		stereotypes := "all"
+		if check.Stereotypes != nil {
+			stereotypes = strings.Join(check.Stereotypes, ",")
+		}
+
+		optIn := "no"
+		if check.OptIn {
+			optIn = "yes"
+		}
+
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\n", check.ID, stereotypes, check.Severity, optIn, check.Description)
+	}
+
+	if err := table.Flush(); err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return ExitScanFailed
+	}
+
+	return ExitClean
+}
+
+// runScaffold prints a constructor stub for every stereotype type without a constructor,
+// or appends the stubs to the files declaring the types with -write.
+func runScaffold(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("dddgo scaffold", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	write := flags.Bool("write", false, "append the stubs to the files declaring the types instead of printing them")
+
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: dddgo scaffold [-write] [path]")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return ExitUsage
+	}
+
+	if flags.NArg() > 1 {
+		flags.Usage()
+
+		return ExitUsage
+	}
+
+	rootPath := "."
+	if flags.NArg() == 1 {
+		rootPath = flags.Arg(0)
+	}
+
+	stubs, err := validator.ScaffoldConstructors(rootPath)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitCodeOf(err)
+	}
+
+	for _, stub := range stubs {
+		if !*write {
+			fmt.Fprintf(stdout, "// %s\n%s\n", stub.File, stub.Source)
+			continue
+		}
+
+		if err := appendStub(stub); err != nil {
+			fmt.Fprintln(stderr, err)
+
+			return ExitScanFailed
+		}
+
+		fmt.Fprintf(stdout, "%s: added New%s\n", stub.File, stub.TypeName[strings.LastIndex(stub.TypeName, ".")+1:])
+	}
+
+	return ExitClean
+}
+
+// appendStub appends a constructor stub to the end of the file declaring its type.
+func appendStub(stub *helpers.ConstructorStub) error {
+	path := filepath.FromSlash(stub.File)
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if len(src) > 0 && src[len(src)-1] != '\n' {
+		src = append(src, '\n')
+	}
+
+	src = append(src, '\n')
+	src = append(src, stub.Source...)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, src, info.Mode().Perm())
+}
+
+// flagSet reports whether a flag was given on the command line.
+func flagSet(flags *flag.FlagSet, name string) bool {
+	set := false
+
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+
+	return set
+}
+
+// exitCodeOf maps a validation error to the exit code reported for it.
+func exitCodeOf(err error) int {
+	if errors.Is(err, helpers.ErrInvalidConfig) || errors.Is(err, helpers.ErrEntrypointNotFound) {
+		return ExitUsage
+	}
+
+	return ExitScanFailed
+}
+
+// useColor resolves the color mode: auto colors terminals only and honours the NO_COLOR convention.
+func useColor(mode string, w io.Writer) bool {
+	switch mode {
+	case colorAlways:
+		return true
+	case colorNever:
+		return false
+	}
+
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// colorize wraps a rendered violation in the color of its severity, errors red and warnings yellow.
+func colorize(severity helpers.Severity, line string) string {
+	switch severity {
+	case helpers.SeverityError:
+		return ansiRed + line + ansiReset
+	case helpers.SeverityWarning:
+		return ansiYellow + line + ansiReset
+	default:
+		return line
+	}
+}
+
+// writeText prints every violation, sorted by location,
+// each followed by its suggested fix in compiler style when suggestions are enabled.
+// The minimum severity only decides the exit code, so warnings stay visible in a run failing on errors only.
+// With color the violations are colored by severity.
+func writeText(w io.Writer, reports map[string]*helpers.Report, color bool) error {
+	var violations []*helpers.Violation
+
+	for _, report := range reports {
+		for _, violation := range report.Violations {
+			violations = append(violations, violation)
+		}
+	}
+
+	sort.Slice(violations, func(i, j int) bool {
+		if violations[i].File != violations[j].File {
+			return violations[i].File < violations[j].File
+		}
+
+		if violations[i].Line != violations[j].Line {
+			return violations[i].Line < violations[j].Line
+		}
+
+		return violations[i].Message < violations[j].Message
+	})
+
+	for _, violation := range violations {
+		line := helpers.FormatViolation(*violation, helpers.FormatOptions{})
+		if color {
+			line = colorize(violation.Severity, line)
+		}
+
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+
+		if violation.Suggestion == "" {
+			continue
+		}
+
+		if _, err := fmt.Fprintf(w, "\t%s:%d: suggestion: %s\n", violation.File, violation.Line, violation.Suggestion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeScanErrors prints every file or directory that could not be read once, sorted by path,
+// so a scan covering less than the whole tree does not go unnoticed.
+func writeScanErrors(w io.Writer, reports map[string]*helpers.Report) {
+	merged := &helpers.Report{}
+
+	for _, report := range reports {
+		merged.Merge(report)
+	}
+
+	sort.Slice(merged.ScanErrors, func(i, j int) bool {
+		return merged.ScanErrors[i].Path < merged.ScanErrors[j].Path
+	})
+
+	for _, scanError := range merged.ScanErrors {
+		fmt.Fprintf(w, "skipped %s: %s\n", scanError.Path, scanError.Message)
+	}
+}
+
+// writeMetrics prints the timings of every stereotype, sorted by stereotype name.
+func writeMetrics(w io.Writer, reports map[string]*helpers.Report) {
+	names := make([]string, 0, len(reports))
+	for name := range reports {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		m := reports[name].Metrics
+		if m == nil {
+			continue
+		}
+
+		fmt.Fprintf(w, "%s: files=%d parse=%s walk=%s types=%s constructors=%s checks=%s\n",
+			name, m.Files, m.Parse, m.Walk, m.TypeDiscovery, m.ConstructorDiscovery, m.ViolationDetection)
+	}
+}
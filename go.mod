@@ -2,4 +2,7 @@ module github.com/nobuenhombre/dddgo
 
 go 1.24
 
-require github.com/nobuenhombre/suikat v0.0.159
+require (
+	github.com/nobuenhombre/suikat v0.0.159
+	gopkg.in/yaml.v3 v3.0.1
+)
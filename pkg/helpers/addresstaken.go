@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindAddressTakenArguments scans calls for arguments taking the address of a SomeObject variable,
+// e.g. `apply(&price)`, which hands the value out by reference for the callee to mutate or share.
+// Variables are typed heuristically from local declarations and parameters, see expressionTyper.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//   - constructors: A map of constructor information, see FindConstructors
+//
+// Returns:
+//   - A map of violation messages to address taken violations
+//   - An error if the scan fails, nil otherwise
+func FindAddressTakenArguments(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	constructorTypes := constructorTypeNames(constructors)
+	violations := make(map[string]*Violation)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		typer := newExpressionTyper(file, packages, typeDeclarations, constructorTypes)
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			variables := make(map[string]string)
+			typer.declareParameters(funcDecl, variables)
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				switch node := n.(type) {
+				case *ast.ValueSpec, *ast.AssignStmt:
+					typer.declare(node, variables)
+				case *ast.CallExpr:
+					for _, arg := range node.Args {
+						unary, ok := ast.Unparen(arg).(*ast.UnaryExpr)
+						if !ok || unary.Op != token.AND {
+							continue
+						}
+
+						ident, ok := ast.Unparen(unary.X).(*ast.Ident)
+						if !ok {
+							continue
+						}
+
+						typeKey, ok := variables[ident.Name]
+						if !ok {
+							continue
+						}
+
+						position := fileSet.Position(unary.Pos())
+
+						message := fmt.Sprintf("VIOLATION: Address of %s %s %s passed to %s at %s:%d (%s)", markerName, typeKey, ident.Name, types.ExprString(node.Fun), path, position.Line, checkName)
+						violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+					}
+				}
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
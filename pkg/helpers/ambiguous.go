@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FindAmbiguousConstructors reports constructors discovered for the types of more than one stereotype,
+// e.g. a NewMoney returning a struct that embeds both the ValueObject and the Entity marker,
+// which FindConstructors attributes to whichever stereotype is validated. The violation lists
+// the conflicting stereotypes, pointing at the misconfigured type rather than at the symptoms in each report.
+//
+// Parameters:
+//   - checkName: The identifier of the check reported with each violation
+//   - constructors: A map of stereotype marker names to the constructors discovered for their types, see FindConstructors
+//
+// Returns:
+//   - A map of violation messages to ambiguous constructor violations
+func FindAmbiguousConstructors(checkName string, constructors map[string]map[string]*ConstructorInfo) map[string]*Violation {
+	// file:function -> stereotypes and the first constructor info seen
+	stereotypes := make(map[string]map[string]bool)
+	infos := make(map[string]*ConstructorInfo)
+
+	for markerName, stereotypeConstructors := range constructors {
+		for _, constructor := range stereotypeConstructors {
+			key := constructor.File + ":" + constructor.Name
+
+			if stereotypes[key] == nil {
+				stereotypes[key] = make(map[string]bool)
+				infos[key] = constructor
+			}
+
+			stereotypes[key][markerName] = true
+		}
+	}
+
+	violations := make(map[string]*Violation)
+
+	for key, markerNames := range stereotypes {
+		if len(markerNames) < 2 {
+			continue
+		}
+
+		names := make([]string, 0, len(markerNames))
+		for markerName := range markerNames {
+			names = append(names, markerName)
+		}
+
+		sort.Strings(names)
+
+		constructor := infos[key]
+
+		message := fmt.Sprintf("VIOLATION: Constructor %s of %s is classified as %s at %s:%d (%s)", constructor.Name, constructor.TypeName, strings.Join(names, " and "), constructor.File, constructor.StartLine, checkName)
+		violations[message] = NewViolation(checkName, constructor.TypeName, constructor.File, constructor.StartLine, message)
+	}
+
+	return violations
+}
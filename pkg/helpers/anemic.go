@@ -0,0 +1,91 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// IsTrivialGetter reports whether a method only returns a field of its receiver, e.g.
+// func (c Customer) Name() string { return c.name }.
+//
+// Parameters:
+//   - funcDecl: The method declaration
+//
+// Returns:
+//   - true if the method takes no parameters and its body is a single receiver field return, false otherwise
+func IsTrivialGetter(funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Body == nil || len(funcDecl.Body.List) != 1 || funcDecl.Type.Params.NumFields() != 0 {
+		return false
+	}
+
+	if funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 || len(funcDecl.Recv.List[0].Names) == 0 {
+		return false
+	}
+
+	returnStmt, ok := funcDecl.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(returnStmt.Results) != 1 {
+		return false
+	}
+
+	selector, ok := ast.Unparen(returnStmt.Results[0]).(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	ident, ok := selector.X.(*ast.Ident)
+
+	return ok && ident.Name == funcDecl.Recv.List[0].Names[0].Name
+}
+
+// FindAnemicTypes reports SomeObject types without behavior, having no methods besides trivial getters.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//
+// Returns:
+//   - A map of violation messages to anemic type violations
+//   - An error if the scan fails, nil otherwise
+func FindAnemicTypes(walk Walker, checkName string, markerName string, locations map[string]*TypeLocation) (map[string]*Violation, error) {
+	behavior := make(map[string]bool)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+
+			typeKey, _, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			if !ok || locations[typeKey] == nil || IsTrivialGetter(funcDecl) {
+				continue
+			}
+
+			behavior[typeKey] = true
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	violations := make(map[string]*Violation)
+
+	for typeKey, location := range locations {
+		if behavior[typeKey] {
+			continue
+		}
+
+		message := fmt.Sprintf("VIOLATION: %s %s has no behavior besides trivial getters at %s:%d (%s)", markerName, typeKey, location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations, nil
+}
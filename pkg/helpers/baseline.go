@@ -0,0 +1,105 @@
+package helpers
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// BaselineEntry identifies accepted violations by type and check, not by line,
+// so they stay suppressed when code moves.
+type BaselineEntry struct {
+	Check    string `json:"check"`
+	TypeName string `json:"typeName"`
+}
+
+// Baseline is the content of a baseline file listing accepted, pre-existing violations.
+type Baseline struct {
+	Violations []BaselineEntry `json:"violations"`
+}
+
+// NewBaseline collects the violations of reports into a baseline.
+//
+// Parameters:
+//   - reports: Reports keyed by stereotype marker name
+//
+// Returns:
+//   - The baseline with one sorted entry per type and check
+func NewBaseline(reports map[string]*Report) *Baseline {
+	seen := make(map[BaselineEntry]bool)
+	baseline := &Baseline{Violations: []BaselineEntry{}}
+
+	for _, report := range reports {
+		for _, violation := range report.Violations {
+			entry := BaselineEntry{Check: violation.Check, TypeName: violation.TypeName}
+			if seen[entry] {
+				continue
+			}
+
+			seen[entry] = true
+			baseline.Violations = append(baseline.Violations, entry)
+		}
+	}
+
+	sort.Slice(baseline.Violations, func(i, j int) bool {
+		if baseline.Violations[i].TypeName != baseline.Violations[j].TypeName {
+			return baseline.Violations[i].TypeName < baseline.Violations[j].TypeName
+		}
+
+		return baseline.Violations[i].Check < baseline.Violations[j].Check
+	})
+
+	return baseline
+}
+
+// LoadBaseline reads a baseline file.
+//
+// Parameters:
+//   - fileName: The baseline file path
+//
+// Returns:
+//   - The set of accepted violations
+//   - An error if the file cannot be read or parsed, nil otherwise
+func LoadBaseline(fileName string) (map[BaselineEntry]bool, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, ge.Pin(err, ge.Params{"fileName": fileName})
+	}
+
+	baseline := &Baseline{}
+
+	err = json.Unmarshal(data, baseline)
+	if err != nil {
+		return nil, ge.Pin(err, ge.Params{"fileName": fileName})
+	}
+
+	entries := make(map[BaselineEntry]bool, len(baseline.Violations))
+	for _, entry := range baseline.Violations {
+		entries[entry] = true
+	}
+
+	return entries, nil
+}
+
+// Write stores the baseline as an indented JSON file.
+//
+// Parameters:
+//   - fileName: The baseline file path
+//
+// Returns:
+//   - An error if the file cannot be written, nil otherwise
+func (b *Baseline) Write(fileName string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return ge.Pin(err)
+	}
+
+	err = os.WriteFile(fileName, append(data, '\n'), 0o644)
+	if err != nil {
+		return ge.Pin(err, ge.Params{"fileName": fileName})
+	}
+
+	return nil
+}
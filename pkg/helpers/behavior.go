@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// serializationMethods lists the methods of the standard encoding and fmt interfaces,
+// which render or decode a value rather than add behavior to it.
+var serializationMethods = map[string]bool{
+	"String":          true,
+	"GoString":        true,
+	"MarshalJSON":     true,
+	"UnmarshalJSON":   true,
+	"MarshalText":     true,
+	"UnmarshalText":   true,
+	"MarshalBinary":   true,
+	"UnmarshalBinary": true,
+	"AppendText":      true,
+	"AppendBinary":    true,
+}
+
+// FindBehaviorMethods reports every method of SomeObjects that is neither a trivial getter, see IsTrivialGetter,
+// nor a serialization method such as MarshalJSON or String, e.g. business logic leaking into a Command,
+// which is meant to carry intent and nothing else.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to behavior method violations
+//   - An error if the scan fails, nil otherwise
+func FindBehaviorMethods(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			typeKey, _, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			if !ok || !typeDeclarations[typeKey] {
+				continue
+			}
+
+			if IsTrivialGetter(funcDecl) || serializationMethods[funcDecl.Name.Name] {
+				continue
+			}
+
+			position := fileSet.Position(funcDecl.Pos())
+
+			message := fmt.Sprintf("VIOLATION: %s %s has behavior in method %s at %s:%d (%s)", markerName, typeKey, funcDecl.Name.Name, path, position.Line, checkName)
+			violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,84 @@
+package helpers
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// zeroValueViolations runs the zero value check with options over rootPath.
+func zeroValueViolations(t *testing.T, rootPath string, options *Options) map[string]*Violation {
+	t.Helper()
+
+	walk := options.Walker(rootPath)
+
+	types, err := FindTypeDeclarationsInWalk(walk, options.TypeDeclaration(valueObjectPackage, "_", "ValueObject"))
+	if err != nil {
+		t.Fatalf("FindTypeDeclarationsInWalk() error = %v", err)
+	}
+
+	constructors, err := FindConstructorsInWalk(walk, types)
+	if err != nil {
+		t.Fatalf("FindConstructorsInWalk() error = %v", err)
+	}
+
+	violations, err := FindZeroValueInitializationsInWalk(walk, "ValueObject", types, constructors)
+	if err != nil {
+		t.Fatalf("FindZeroValueInitializationsInWalk() error = %v", err)
+	}
+
+	return violations
+}
+
+func TestWithBuildTagsReportsGatedCodeOnlyWhenActive(t *testing.T) {
+	const rootPath = "testdata/buildtags"
+
+	if got := zeroValueViolations(t, rootPath, NewOptions(WithBuildTags())); len(got) != 0 {
+		t.Errorf("without enterprise: violations = %v, want none", got)
+	}
+
+	if got := zeroValueViolations(t, rootPath, NewOptions(WithBuildTags("enterprise"))); len(got) != 1 {
+		t.Errorf("with enterprise: violations = %v, want 1", got)
+	}
+}
+
+func TestMatchBuildTagsReadsParsedFilesOnly(t *testing.T) {
+	fileSet := token.NewFileSet()
+
+	var files []*ast.File
+
+	for _, name := range []string{"money.go", "enterprise.go"} {
+		src, err := os.ReadFile(filepath.Join("testdata/buildtags/shop", name))
+		if err != nil {
+			t.Fatalf("os.ReadFile() error = %v", err)
+		}
+
+		// The files are parsed under paths that do not exist, matching them must not read the file system
+		file, err := parser.ParseFile(fileSet, "missing/shop/"+name, src, parser.ParseComments)
+		if err != nil {
+			t.Fatalf("parser.ParseFile() error = %v", err)
+		}
+
+		files = append(files, file)
+	}
+
+	tests := []struct {
+		name string
+		tags []string
+		want int
+	}{
+		{name: "inactive", tags: []string{}, want: 1},
+		{name: "active", tags: []string{"enterprise"}, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchBuildTags(ParsedFiles(fileSet, files...), tt.tags); len(got) != tt.want {
+				t.Errorf("MatchBuildTags() kept %d files, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
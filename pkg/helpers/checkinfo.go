@@ -0,0 +1,103 @@
+package helpers
+
+import "sort"
+
+// CheckInfo describes a check for listings such as `dddgo rules` and for configuration files referencing check identifiers.
+//
+// Fields:
+//   - ID: The check identifier, e.g. "zero-value-initialization"
+//   - Stereotypes: The marker names of the stereotypes the check runs for, nil for every stereotype
+//   - Severity: The default severity of the check, see SeverityOf
+//   - OptIn: Whether the check only runs when enabled with WithEnabledChecks, see IsOptInCheck
+//   - Description: A one-line description of what the check flags
+type CheckInfo struct {
+	ID          string   `json:"id"`
+	Stereotypes []string `json:"stereotypes,omitempty"`
+	Severity    Severity `json:"severity"`
+	OptIn       bool     `json:"optIn"`
+	Description string   `json:"description"`
+}
+
+// checkDoc holds the stereotypes and description of a check.
+type checkDoc struct {
+	stereotypes []string
+	description string
+}
+
+// checkDocs documents every known check, nil stereotypes mark checks run for every stereotype.
+var checkDocs = map[string]checkDoc{
+	CheckZeroValueInitialization:        {nil, "Values initialized with a composite literal outside their constructors"},
+	CheckPiecemealConstruction:          {nil, "Zero values declared and then filled field by field outside constructors"},
+	CheckPointerMarker:                  {nil, "Marker fields declared as pointers"},
+	CheckMarkerNotLast:                  {nil, "Marker fields declared before other fields"},
+	CheckShadowedMarkerName:             {nil, "Data fields named like the marker of their stereotype"},
+	CheckMarkerMisuse:                   {nil, "Markers used outside the \"_\" marker field of a struct"},
+	CheckReflectiveConstruction:         {nil, "Values created through reflect.New or reflect.Zero"},
+	CheckStereotypeInMain:               {nil, "Stereotype types declared in package main"},
+	CheckLocalStereotypeDeclaration:     {nil, "Stereotype types declared inside function bodies"},
+	CheckUnusedMarkerImport:             {nil, "Files importing a marker package without any marked type"},
+	CheckNoConstructor:                  {nil, "Types without any constructor"},
+	CheckUnexportedOnlyConstructor:      {nil, "Exported types with only unexported constructors"},
+	CheckStereotypeEmbedding:            {nil, "Embedded types promoting foreign fields and methods"},
+	CheckStereotypeConcurrencyField:     {nil, "Fields of sync or sync/atomic types or channels"},
+	CheckStereotypeImplementsError:      {nil, "Types implementing the error interface"},
+	CheckConstructorOnlyInTests:         {nil, "Types constructed only in test files"},
+	CheckUnusedExportedStereotype:       {nil, "Exported types never referenced outside their package"},
+	CheckTrivialConstructor:             {nil, "Constructors that only return a zero value"},
+	CheckConstructorIgnoresError:        {nil, "Constructors discarding errors with the blank identifier"},
+	CheckUnmarkedDomainStruct:           {nil, "Exported structs in domain packages without any stereotype marker"},
+	CheckDomainTypeSerializedAtBoundary: {nil, "Stereotype types serialized with encoding/json outside the domain layer"},
+	CheckAmbiguousConstructor:           {nil, "Constructors of types classified as more than one stereotype"},
+	CheckEmptyValueObject:               {[]string{"ValueObject"}, "Value Objects whose only field is the marker"},
+	CheckIncompleteConstruction:         {[]string{"ValueObject"}, "Constructor returns leaving Value Object fields unset"},
+	CheckIncompleteEquals:               {[]string{"ValueObject"}, "Equals methods not comparing every field"},
+	CheckLargeValueObject:               {[]string{"ValueObject"}, "Value Objects with more data fields than configured"},
+	CheckValueObjectFieldTags:           {[]string{"ValueObject"}, "Value Object fields carrying struct tags"},
+	CheckNondeterministicValueObject:    {[]string{"ValueObject"}, "Value Object constructors and methods reading the clock or a random source"},
+	CheckValueObjectPointerStringer:     {[]string{"ValueObject"}, "String methods of Value Objects declared on pointer receivers"},
+	CheckValueObjectNonValueField:       {[]string{"ValueObject"}, "Value Object fields holding project structs that are not Value Objects"},
+	CheckValueObjectGlobalMutation:      {[]string{"ValueObject"}, "Value Object methods assigning package-level variables"},
+	CheckValueObjectAddressTaken:        {[]string{"ValueObject"}, "Addresses of Value Object variables passed to functions"},
+	CheckValueObjectDeepEqual:           {[]string{"ValueObject"}, "Value Objects compared with reflect.DeepEqual outside tests"},
+	CheckValueObjectStoredAsPointer:     {[]string{"ValueObject"}, "Pointers to Value Objects whose constructor returns them by value"},
+	CheckEntityAsMapKey:                 {[]string{"Entity"}, "Entities used as map keys instead of their identifiers"},
+	CheckAnemicEntity:                   {[]string{"Entity"}, "Entities without behavior besides trivial getters"},
+	CheckValueObjectMutationViaEntity:   {[]string{"Entity"}, "Value Objects mutated through pointer fields of Entities"},
+	CheckValueObjectReplaced:            {[]string{"Entity"}, "Value Objects replaced through pointer fields of Entities in their methods"},
+	CheckPossibleNilValueObject:         {[]string{"Entity"}, "Entity methods dereferencing pointer fields to Value Objects without a nil check"},
+	CheckAggregateInternalSetter:        {[]string{"Aggregate"}, "Exported mutating methods on non-root Aggregates"},
+	CheckAggregateExposesCollection:     {[]string{"AggregateRoot"}, "Aggregate Root methods returning internal collections"},
+	CheckAggregateWithoutRepository:     {[]string{"AggregateRoot"}, "Aggregate Roots no Repository method accepts or returns"},
+	CheckAggregateRootUnreachable:       {[]string{"AggregateRoot"}, "Aggregate Roots no Command handler references"},
+	CheckAggregateExternalMutation:      {[]string{"AggregateRoot"}, "Aggregate Root fields assigned outside methods of the root"},
+	CheckEmptyCommand:                   {[]string{"Command"}, "Commands whose only field is the marker"},
+	CheckCrossContextCommand:            {[]string{"Command"}, "Commands only handled from other bounded contexts"},
+	CheckCommandWithBehavior:            {[]string{"Command"}, "Command methods besides trivial getters and serialization"},
+	CheckCommandQueryConflict:           {[]string{"Command", "Query"}, "Types marked both as a Command and as a Query"},
+}
+
+// ListChecks returns every known check with its stereotypes, default severity and description.
+//
+// Returns:
+//   - The checks sorted by identifier
+func ListChecks() []CheckInfo {
+	checks := make([]CheckInfo, 0, len(checkSeverities))
+
+	for id, severity := range checkSeverities {
+		doc := checkDocs[id]
+
+		checks = append(checks, CheckInfo{
+			ID:          id,
+			Stereotypes: doc.stereotypes,
+			Severity:    severity,
+			OptIn:       IsOptInCheck(id),
+			Description: doc.description,
+		})
+	}
+
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].ID < checks[j].ID
+	})
+
+	return checks
+}
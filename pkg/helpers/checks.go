@@ -0,0 +1,158 @@
+package helpers
+
+// Identifiers of the checks performed by the validators.
+const (
+	CheckZeroValueInitialization        = "zero-value-initialization"
+	CheckEmptyValueObject               = "empty-value-object"
+	CheckEntityAsMapKey                 = "entity-as-map-key"
+	CheckIncompleteConstruction         = "incomplete-construction"
+	CheckPointerMarker                  = "pointer-marker"
+	CheckMarkerNotLast                  = "marker-not-last"
+	CheckAggregateInternalSetter        = "aggregate-internal-setter"
+	CheckTrivialConstructor             = "trivial-constructor"
+	CheckReflectiveConstruction         = "reflective-construction"
+	CheckValueObjectMutationViaEntity   = "value-object-mutation-via-entity"
+	CheckStereotypeInMain               = "stereotype-in-main"
+	CheckNoConstructor                  = "no-constructor"
+	CheckValueObjectFieldTags           = "value-object-field-tags"
+	CheckNondeterministicValueObject    = "non-deterministic-value-object"
+	CheckCrossContextCommand            = "cross-context-command"
+	CheckValueObjectStoredAsPointer     = "value-object-stored-as-pointer"
+	CheckStereotypeEmbedding            = "stereotype-embedding"
+	CheckPiecemealConstruction          = "piecemeal-construction"
+	CheckAnemicEntity                   = "anemic-entity"
+	CheckAggregateExposesCollection     = "aggregate-exposes-collection"
+	CheckCommandQueryConflict           = "command-query-conflict"
+	CheckStereotypeImplementsError      = "stereotype-implements-error"
+	CheckLocalStereotypeDeclaration     = "local-stereotype-declaration"
+	CheckUnusedExportedStereotype       = "unused-exported-stereotype"
+	CheckUnusedMarkerImport             = "unused-marker-import"
+	CheckPossibleNilValueObject         = "possible-nil-value-object"
+	CheckUnmarkedDomainStruct           = "unmarked-domain-struct"
+	CheckUnexportedOnlyConstructor      = "unexported-only-constructor"
+	CheckIncompleteEquals               = "incomplete-equals"
+	CheckConstructorOnlyInTests         = "constructor-only-in-tests"
+	CheckMarkerMisuse                   = "marker-misuse"
+	CheckAggregateWithoutRepository     = "aggregate-without-repository"
+	CheckLargeValueObject               = "large-value-object"
+	CheckValueObjectPointerStringer     = "value-object-pointer-stringer"
+	CheckValueObjectNonValueField       = "value-object-non-value-field"
+	CheckValueObjectGlobalMutation      = "value-object-global-mutation"
+	CheckEmptyCommand                   = "empty-command"
+	CheckAggregateRootUnreachable       = "aggregate-root-unreachable"
+	CheckStereotypeConcurrencyField     = "stereotype-concurrency-field"
+	CheckDomainTypeSerializedAtBoundary = "domain-type-serialized-at-boundary"
+	CheckAmbiguousConstructor           = "ambiguous-constructor"
+	CheckValueObjectReplaced            = "value-object-replaced"
+	CheckAggregateExternalMutation      = "aggregate-external-mutation"
+	CheckValueObjectAddressTaken        = "value-object-address-taken"
+	CheckConstructorIgnoresError        = "constructor-ignores-error"
+	CheckValueObjectDeepEqual           = "value-object-deepequal"
+	CheckShadowedMarkerName             = "shadowed-marker-name"
+	CheckCommandWithBehavior            = "command-with-behavior"
+)
+
+// checkSeverities holds the severity reported for every known check.
+var checkSeverities = map[string]Severity{
+	CheckZeroValueInitialization:        SeverityError,
+	CheckEmptyValueObject:               SeverityWarning,
+	CheckEntityAsMapKey:                 SeverityError,
+	CheckIncompleteConstruction:         SeverityWarning,
+	CheckPointerMarker:                  SeverityError,
+	CheckMarkerNotLast:                  SeverityInfo,
+	CheckAggregateInternalSetter:        SeverityError,
+	CheckTrivialConstructor:             SeverityInfo,
+	CheckReflectiveConstruction:         SeverityWarning,
+	CheckValueObjectMutationViaEntity:   SeverityWarning,
+	CheckStereotypeInMain:               SeverityError,
+	CheckNoConstructor:                  SeverityWarning,
+	CheckValueObjectFieldTags:           SeverityInfo,
+	CheckNondeterministicValueObject:    SeverityWarning,
+	CheckCrossContextCommand:            SeverityWarning,
+	CheckValueObjectStoredAsPointer:     SeverityWarning,
+	CheckStereotypeEmbedding:            SeverityWarning,
+	CheckPiecemealConstruction:          SeverityError,
+	CheckAnemicEntity:                   SeverityInfo,
+	CheckAggregateExposesCollection:     SeverityWarning,
+	CheckCommandQueryConflict:           SeverityError,
+	CheckStereotypeImplementsError:      SeverityWarning,
+	CheckLocalStereotypeDeclaration:     SeverityWarning,
+	CheckUnusedExportedStereotype:       SeverityInfo,
+	CheckUnusedMarkerImport:             SeverityInfo,
+	CheckPossibleNilValueObject:         SeverityInfo,
+	CheckUnmarkedDomainStruct:           SeverityError,
+	CheckUnexportedOnlyConstructor:      SeverityWarning,
+	CheckIncompleteEquals:               SeverityInfo,
+	CheckConstructorOnlyInTests:         SeverityInfo,
+	CheckMarkerMisuse:                   SeverityWarning,
+	CheckAggregateWithoutRepository:     SeverityWarning,
+	CheckLargeValueObject:               SeverityInfo,
+	CheckValueObjectPointerStringer:     SeverityInfo,
+	CheckValueObjectNonValueField:       SeverityInfo,
+	CheckValueObjectGlobalMutation:      SeverityInfo,
+	CheckEmptyCommand:                   SeverityWarning,
+	CheckAggregateRootUnreachable:       SeverityWarning,
+	CheckStereotypeConcurrencyField:     SeverityWarning,
+	CheckDomainTypeSerializedAtBoundary: SeverityWarning,
+	CheckAmbiguousConstructor:           SeverityError,
+	CheckValueObjectReplaced:            SeverityWarning,
+	CheckAggregateExternalMutation:      SeverityWarning,
+	CheckValueObjectAddressTaken:        SeverityInfo,
+	CheckConstructorIgnoresError:        SeverityInfo,
+	CheckValueObjectDeepEqual:           SeverityInfo,
+	CheckShadowedMarkerName:             SeverityInfo,
+	CheckCommandWithBehavior:            SeverityInfo,
+}
+
+// optInChecks holds the heuristic checks that only run when explicitly enabled.
+var optInChecks = map[string]bool{
+	CheckIncompleteConstruction:      true,
+	CheckTrivialConstructor:          true,
+	CheckValueObjectFieldTags:        true,
+	CheckNondeterministicValueObject: true,
+	CheckCrossContextCommand:         true,
+	CheckValueObjectStoredAsPointer:  true,
+	CheckAnemicEntity:                true,
+	CheckUnusedExportedStereotype:    true,
+	CheckPossibleNilValueObject:      true,
+	CheckIncompleteEquals:            true,
+	CheckConstructorOnlyInTests:      true,
+	CheckAggregateWithoutRepository:  true,
+	CheckValueObjectPointerStringer:  true,
+	CheckValueObjectNonValueField:    true,
+	CheckValueObjectGlobalMutation:   true,
+	CheckAggregateRootUnreachable:    true,
+	CheckMarkerNotLast:               true,
+	CheckValueObjectAddressTaken:     true,
+	CheckConstructorIgnoresError:     true,
+	CheckValueObjectDeepEqual:        true,
+	CheckShadowedMarkerName:          true,
+	CheckCommandWithBehavior:         true,
+}
+
+// SeverityOf returns the severity of a check.
+//
+// Parameters:
+//   - check: The check identifier
+//
+// Returns:
+//   - The severity registered for the check, SeverityError for unknown checks
+func SeverityOf(check string) Severity {
+	severity, ok := checkSeverities[check]
+	if !ok {
+		return SeverityError
+	}
+
+	return severity
+}
+
+// IsOptInCheck reports whether a check only runs when explicitly enabled.
+//
+// Parameters:
+//   - check: The check identifier
+//
+// Returns:
+//   - true for opt-in checks, false otherwise
+func IsOptInCheck(check string) bool {
+	return optInChecks[check]
+}
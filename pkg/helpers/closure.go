@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// ReachableFiles restricts files to the import closure of an entrypoint package, the package itself
+// and every package of the module it imports directly or transitively. Import paths of directories
+// are derived from the nearest go.mod file, see ModuleImportPath, imports of other modules are not followed.
+//
+// Parameters:
+//   - files: The parsed files
+//   - entrypoint: The import path of the entrypoint package, e.g. "example.com/shop/ordering"
+//
+// Returns:
+//   - The files of the reachable packages, in their original order
+//   - An error wrapping ErrEntrypointNotFound if no parsed file belongs to the entrypoint package, nil otherwise
+func ReachableFiles(files []*SourceFile, entrypoint string) ([]*SourceFile, error) {
+	// import path -> files of the package
+	packageFiles := make(map[string][]*SourceFile)
+	importPaths := make(map[string]string)
+
+	for _, file := range files {
+		dir := filepath.Dir(file.Path)
+
+		importPath, ok := importPaths[dir]
+		if !ok {
+			importPath, _ = ModuleImportPath(dir)
+			importPaths[dir] = importPath
+		}
+
+		if importPath != "" {
+			packageFiles[importPath] = append(packageFiles[importPath], file)
+		}
+	}
+
+	if packageFiles[entrypoint] == nil {
+		return nil, ge.Pin(fmt.Errorf("%w: %s", ErrEntrypointNotFound, entrypoint))
+	}
+
+	reachable := map[string]bool{entrypoint: true}
+	queue := []string{entrypoint}
+
+	for len(queue) > 0 {
+		importPath := queue[0]
+		queue = queue[1:]
+
+		for _, file := range packageFiles[importPath] {
+			for _, imp := range file.File.Imports {
+				imported := strings.Trim(imp.Path.Value, `"`)
+
+				if !reachable[imported] && packageFiles[imported] != nil {
+					reachable[imported] = true
+					queue = append(queue, imported)
+				}
+			}
+		}
+	}
+
+	closure := make([]*SourceFile, 0, len(files))
+
+	for _, file := range files {
+		if reachable[importPaths[filepath.Dir(file.Path)]] {
+			closure = append(closure, file)
+		}
+	}
+
+	return closure, nil
+}
@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// collectionElement returns the element type of a slice, array or map type expression,
+// for maps the value type.
+func collectionElement(typeExpr ast.Expr) (ast.Expr, bool) {
+	switch typ := ast.Unparen(typeExpr).(type) {
+	case *ast.ArrayType:
+		return typ.Elt, true
+	case *ast.MapType:
+		return typ.Value, true
+	default:
+		return nil, false
+	}
+}
+
+// FindExposedCollections scans exported methods of holder SomeObjects for results that are
+// slices, arrays or maps of element SomeObjects, e.g. func (o *Order) Lines() []*OrderLine,
+// handing the internal collection out for modification.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The holder marker name used in violation messages
+//   - holderTypes: A map of type names whose methods are inspected
+//   - elementTypes: A map of type names that must not be exposed in collections
+//
+// Returns:
+//   - A map of violation messages to exposed collection violations
+//   - An error if the scan fails, nil otherwise
+func FindExposedCollections(walk Walker, checkName string, markerName string, holderTypes map[string]bool, elementTypes map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || !funcDecl.Name.IsExported() || funcDecl.Type.Results == nil {
+				continue
+			}
+
+			typeKey, _, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			if !ok || !holderTypes[typeKey] {
+				continue
+			}
+
+			for _, result := range funcDecl.Type.Results.List {
+				element, ok := collectionElement(result.Type)
+				if !ok {
+					continue
+				}
+
+				if star, ok := ast.Unparen(element).(*ast.StarExpr); ok {
+					element = star.X
+				}
+
+				elementKey, ok := resolveTypeKey(file, currentPackage, packages, element)
+				if !ok || !elementTypes[elementKey] {
+					continue
+				}
+
+				position := fileSet.Position(funcDecl.Pos())
+
+				message := fmt.Sprintf("VIOLATION: %s %s exposes a collection of %s from %s instead of a copy or an iterator at %s:%d (%s)", markerName, typeKey, elementKey, funcDecl.Name.Name, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
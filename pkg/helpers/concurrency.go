@@ -0,0 +1,121 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// concurrencyPackages lists the import paths whose types hold synchronization state.
+var concurrencyPackages = []string{"sync", "sync/atomic"}
+
+// concurrencyAliases returns the local names of the imported synchronization packages mapped to their import paths.
+func concurrencyAliases(file *ast.File) map[string]string {
+	aliases := make(map[string]string)
+
+	for _, imp := range file.Imports {
+		importPath := strings.Trim(imp.Path.Value, `"`)
+		if !isOneOf(importPath, concurrencyPackages) {
+			continue
+		}
+
+		name := importPath[strings.LastIndex(importPath, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+
+		if name != "_" && name != "." {
+			aliases[name] = importPath
+		}
+	}
+
+	return aliases
+}
+
+// concurrencyFieldType describes a field type holding synchronization state, e.g. "sync.Mutex" or "chan",
+// pointers included, returning false for any other type.
+func concurrencyFieldType(fieldType ast.Expr, aliases map[string]string) (string, bool) {
+	fieldType = ast.Unparen(fieldType)
+	if star, ok := fieldType.(*ast.StarExpr); ok {
+		fieldType = ast.Unparen(star.X)
+	}
+
+	switch typ := fieldType.(type) {
+	case *ast.ChanType:
+		return "chan", true
+	case *ast.SelectorExpr:
+		ident, ok := typ.X.(*ast.Ident)
+		if !ok || ident.Obj != nil {
+			return "", false
+		}
+
+		importPath, ok := aliases[ident.Name]
+		if !ok {
+			return "", false
+		}
+
+		return importPath + "." + typ.Sel.Name, true
+	}
+
+	return "", false
+}
+
+// FindConcurrencyFields scans SomeObject structs for fields of sync or sync/atomic types or channels,
+// which turn values into shared mutable state and break copying them, see go vet's copylocks.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to concurrency field violations
+//   - An error if the scan fails, nil otherwise
+func FindConcurrencyFields(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		aliases := concurrencyAliases(file)
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			typeKey := currentPackage + "." + typeSpec.Name.Name
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok || structType.Fields == nil || !typeDeclarations[typeKey] {
+				return true
+			}
+
+			for _, field := range structType.Fields.List {
+				kind, ok := concurrencyFieldType(field.Type, aliases)
+				if !ok {
+					continue
+				}
+
+				position := fileSet.Position(field.Pos())
+
+				for _, name := range fieldDisplayNames(field) {
+					message := fmt.Sprintf("VIOLATION: Field %s of %s %s holds concurrency state %s at %s:%d (%s)", name, markerName, typeKey, kind, path, position.Line, checkName)
+					violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+				}
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
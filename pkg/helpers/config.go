@@ -0,0 +1,268 @@
+package helpers
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFileNames lists the configuration file names looked up in every directory, in priority order.
+var ConfigFileNames = []string{".dddgo.yaml", ".dddgo.yml", ".dddgo.json"}
+
+// Config is the content of a .dddgo.yaml or .dddgo.json project configuration file.
+//
+// Fields:
+//   - MinSeverity: The lowest severity that makes a report fail ("info", "warning" or "error")
+//   - Packages: Package patterns such as "./internal/domain/..." violations are reported for
+//   - Ignore: Glob patterns of files and directories whose violations are not reported
+//   - Checks: Identifiers of the enabled checks, all default checks are enabled when empty
+//   - Enable: Identifiers of opt-in checks to run in addition to the default ones
+//   - Severities: Severity overrides per check identifier
+//   - Markers: Marker package paths per stereotype name, e.g. "ValueObject"
+//   - AlternativeMarkers: Further markers accepted per stereotype name, e.g. the previous marker package
+//   - ModulePaths: Module paths of marker packages remapped to the module paths the scanned code imports them from
+//   - Baseline: Path of a baseline file, relative to the configuration file
+//   - SkipGenerated: When true, violations in generated files are not reported
+//   - GeneratedChecks: Identifiers of the checks still reported in generated files
+//   - PackageOverrides: Severity names, or "off", per package pattern, see WithPackageOverrides
+//   - ErrorTypes: Type names allowed to implement the error interface
+//   - WarningsAsErrors: When true, warning violations are reported as errors
+//   - ExportedOnly: When true, only exported types are validated
+//   - InterfaceFactories: When true, interface-returning factories count as constructors
+//   - MaxValueObjectFields: The largest number of data fields of a Value Object, 0 disables the limit
+//   - BuildTags: The active build tags, files excluded by their build constraints are not scanned
+//   - StrictScan: When true, files and directories that cannot be read fail the run
+//   - Entrypoint: The import path of the package whose import closure is validated, see WithEntrypoint
+//   - Layers: The layer classification of the project's packages, see LayersConfig
+type Config struct {
+	MinSeverity          string              `yaml:"min-severity" json:"min-severity"`
+	Packages             []string            `yaml:"packages" json:"packages"`
+	Ignore               []string            `yaml:"ignore" json:"ignore"`
+	Checks               []string            `yaml:"checks" json:"checks"`
+	Enable               []string            `yaml:"enable" json:"enable"`
+	Severities           map[string]string   `yaml:"severities" json:"severities"`
+	Markers              map[string]string   `yaml:"markers" json:"markers"`
+	AlternativeMarkers   map[string][]Marker `yaml:"alternative-markers" json:"alternative-markers"`
+	ModulePaths          map[string]string   `yaml:"module-paths" json:"module-paths"`
+	Baseline             string              `yaml:"baseline" json:"baseline"`
+	SkipGenerated        bool                `yaml:"skip-generated" json:"skip-generated"`
+	GeneratedChecks      []string            `yaml:"generated-checks" json:"generated-checks"`
+	PackageOverrides     map[string]string   `yaml:"package-overrides" json:"package-overrides"`
+	ErrorTypes           []string            `yaml:"error-types" json:"error-types"`
+	WarningsAsErrors     bool                `yaml:"warnings-as-errors" json:"warnings-as-errors"`
+	ExportedOnly         bool                `yaml:"exported-only" json:"exported-only"`
+	InterfaceFactories   bool                `yaml:"interface-factories" json:"interface-factories"`
+	MaxValueObjectFields int                 `yaml:"max-value-object-fields" json:"max-value-object-fields"`
+	BuildTags            []string            `yaml:"build-tags" json:"build-tags"`
+	StrictScan           bool                `yaml:"strict-scan" json:"strict-scan"`
+	Entrypoint           string              `yaml:"entrypoint" json:"entrypoint"`
+	Layers               LayersConfig        `yaml:"layers" json:"layers"`
+
+	dir string
+}
+
+// LayersConfig is the layers section of a configuration file, e.g.
+//
+//	layers:
+//	  domain:
+//	    - ./internal/domain/...
+//
+// Fields:
+//   - Domain: Package patterns of the domain layer, see WithDomainPackages
+type LayersConfig struct {
+	Domain []string `yaml:"domain" json:"domain"`
+}
+
+// FindConfigFile looks for a configuration file starting at startPath and walking up the directory tree.
+//
+// Parameters:
+//   - startPath: The directory to start the search from, usually the scan root
+//
+// Returns:
+//   - The path of the configuration file if found, empty string otherwise
+//   - An error if startPath cannot be resolved, nil otherwise
+func FindConfigFile(startPath string) (string, error) {
+	current, err := filepath.Abs(startPath)
+	if err != nil {
+		return "", ge.Pin(err)
+	}
+
+	for {
+		for _, name := range ConfigFileNames {
+			candidate := filepath.Join(current, name)
+
+			info, err := os.Stat(candidate)
+			if err == nil && !info.IsDir() {
+				return candidate, nil
+			}
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			break
+		}
+
+		current = parent
+	}
+
+	return "", nil
+}
+
+// LoadConfig reads a configuration file in YAML or JSON format depending on its extension.
+//
+// Parameters:
+//   - fileName: The configuration file path
+//
+// Returns:
+//   - The parsed configuration
+//   - An error if the file cannot be read or parsed, nil otherwise
+func LoadConfig(fileName string) (*Config, error) {
+	config := &Config{}
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, ge.Pin(err, ge.Params{"fileName": fileName})
+	}
+
+	if filepath.Ext(fileName) == ".json" {
+		err = json.Unmarshal(data, config)
+	} else {
+		err = yaml.Unmarshal(data, config)
+	}
+
+	if err != nil {
+		return nil, ge.Pin(err, ge.Params{"fileName": fileName})
+	}
+
+	config.dir = filepath.Dir(fileName)
+
+	return config, nil
+}
+
+// Options converts the configuration into option functions.
+//
+// Returns:
+//   - The option functions equivalent to the configuration
+//   - An error if a severity name is unknown, nil otherwise
+func (c *Config) Options() ([]Option, error) {
+	var opts []Option
+
+	if c.MinSeverity != "" {
+		severity, err := ParseSeverity(c.MinSeverity)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		opts = append(opts, WithMinSeverity(severity))
+	}
+
+	if len(c.Packages) > 0 {
+		opts = append(opts, WithPackages(c.Packages...))
+	}
+
+	if len(c.Ignore) > 0 {
+		opts = append(opts, WithIgnore(c.Ignore...))
+	}
+
+	if len(c.Checks) > 0 {
+		opts = append(opts, WithChecks(c.Checks...))
+	}
+
+	if len(c.Enable) > 0 {
+		opts = append(opts, WithEnabledChecks(c.Enable...))
+	}
+
+	for check, name := range c.Severities {
+		severity, err := ParseSeverity(name)
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"check": check})
+		}
+
+		opts = append(opts, WithSeverity(check, severity))
+	}
+
+	for declaredName, fullPackage := range c.Markers {
+		opts = append(opts, WithMarkerPackage(declaredName, fullPackage))
+	}
+
+	for declaredName, markers := range c.AlternativeMarkers {
+		opts = append(opts, WithAlternativeMarkers(declaredName, markers...))
+	}
+
+	for from, to := range c.ModulePaths {
+		opts = append(opts, WithModulePath(from, to))
+	}
+
+	if c.Baseline != "" {
+		baseline := c.Baseline
+		if !filepath.IsAbs(baseline) {
+			baseline = filepath.Join(c.dir, baseline)
+		}
+
+		opts = append(opts, WithBaseline(baseline))
+	}
+
+	if c.SkipGenerated {
+		opts = append(opts, WithSkipGenerated())
+	}
+
+	if len(c.GeneratedChecks) > 0 {
+		opts = append(opts, WithGeneratedChecks(c.GeneratedChecks...))
+	}
+
+	if len(c.PackageOverrides) > 0 {
+		overrides := make(map[string]SeverityOverride, len(c.PackageOverrides))
+
+		for pattern, name := range c.PackageOverrides {
+			override, err := ParseSeverityOverride(name)
+			if err != nil {
+				return nil, ge.Pin(err, ge.Params{"pattern": pattern})
+			}
+
+			overrides[pattern] = override
+		}
+
+		opts = append(opts, WithPackageOverrides(overrides))
+	}
+
+	if len(c.ErrorTypes) > 0 {
+		opts = append(opts, WithErrorTypes(c.ErrorTypes...))
+	}
+
+	if c.WarningsAsErrors {
+		opts = append(opts, WithWarningsAsErrors(true))
+	}
+
+	if c.ExportedOnly {
+		opts = append(opts, WithExportedOnly(true))
+	}
+
+	if c.InterfaceFactories {
+		opts = append(opts, WithInterfaceFactories())
+	}
+
+	if c.MaxValueObjectFields > 0 {
+		opts = append(opts, WithMaxValueObjectFields(c.MaxValueObjectFields))
+	}
+
+	if c.BuildTags != nil {
+		opts = append(opts, WithBuildTags(c.BuildTags...))
+	}
+
+	if c.StrictScan {
+		opts = append(opts, WithStrictScan(true))
+	}
+
+	if c.Entrypoint != "" {
+		opts = append(opts, WithEntrypoint(c.Entrypoint))
+	}
+
+	if len(c.Layers.Domain) > 0 {
+		opts = append(opts, WithDomainPackages(c.Layers.Domain...))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,32 @@
+package helpers
+
+import (
+	"fmt"
+)
+
+// FindConflictingMarkers reports SomeObject types that also carry the marker of a conflicting stereotype,
+// e.g. a type marked both as a Command and as a Query.
+//
+// Parameters:
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - conflictingName: The marker name of the conflicting stereotype
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//   - conflictingTypes: A map of type names carrying the conflicting marker
+//
+// Returns:
+//   - A map of violation messages to marker conflict violations
+func FindConflictingMarkers(checkName string, markerName string, conflictingName string, locations map[string]*TypeLocation, conflictingTypes map[string]bool) map[string]*Violation {
+	violations := make(map[string]*Violation)
+
+	for typeKey, location := range locations {
+		if !conflictingTypes[typeKey] {
+			continue
+		}
+
+		message := fmt.Sprintf("VIOLATION: %s %s is also marked as %s, separate writes from reads at %s:%d (%s)", markerName, typeKey, conflictingName, location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations
+}
@@ -0,0 +1,43 @@
+package helpers
+
+import (
+	"testing"
+)
+
+func TestCopyConstructorsAreConstructorScope(t *testing.T) {
+	const rootPath = "testdata/copyconstructor"
+
+	options := NewOptions()
+	walk := options.Walker(rootPath)
+
+	types, err := FindTypeDeclarationsInWalk(walk, options.TypeDeclaration(valueObjectPackage, "_", "ValueObject"))
+	if err != nil {
+		t.Fatalf("FindTypeDeclarationsInWalk() error = %v", err)
+	}
+
+	constructors, err := FindConstructorsWithFactories(walk, types, false)
+	if err != nil {
+		t.Fatalf("FindConstructorsWithFactories() error = %v", err)
+	}
+
+	copies := make(map[string]bool)
+	for _, constructor := range constructors {
+		copies[constructor.Name] = constructor.Copy
+	}
+
+	want := map[string]bool{"NewLocation": false, "NewLocationFrom": true, "Moved": true}
+	for name, copied := range want {
+		if got, ok := copies[name]; !ok || got != copied {
+			t.Errorf("constructor %s found = %v, Copy = %v, want Copy = %v", name, ok, got, copied)
+		}
+	}
+
+	violations, err := FindZeroValueInitializationsInWalk(walk, "ValueObject", types, constructors)
+	if err != nil {
+		t.Fatalf("FindZeroValueInitializationsInWalk() error = %v", err)
+	}
+
+	if len(violations) != 0 {
+		t.Errorf("FindZeroValueInitializationsInWalk() = %v, want no violations", violations)
+	}
+}
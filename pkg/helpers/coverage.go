@@ -0,0 +1,82 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindUnmarkedStructs scans the package level exported structs of the packages matching patterns
+// and reports the ones not marked with any of the given stereotypes.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - rootPath: The scanned root the patterns are relative to
+//   - patterns: The package patterns such as "./internal/domain/...", an empty list matches every package
+//   - isTypeDeclarations: The predicates recognising the structs of every stereotype
+//
+// Returns:
+//   - A map of the inspected type names to whether they are marked
+//   - A map of violation messages to unmarked struct violations
+//   - An error if the scan fails, nil otherwise
+func FindUnmarkedStructs(walk Walker, checkName string, rootPath string, patterns []string, isTypeDeclarations []IsTypeDeclaration) (map[string]bool, map[string]*Violation, error) {
+	types := make(map[string]bool)
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		if !MatchAnyPackagePattern(rootPath, path, patterns) {
+			return
+		}
+
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || !typeSpec.Name.IsExported() {
+					continue
+				}
+
+				structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+				if !ok {
+					continue
+				}
+
+				typeKey := currentPackage + "." + typeSpec.Name.Name
+				marked := false
+
+				for _, isTypeDeclaration := range isTypeDeclarations {
+					if isTypeDeclaration(file, structType) {
+						marked = true
+						break
+					}
+				}
+
+				types[typeKey] = marked
+
+				if marked {
+					continue
+				}
+
+				position := fileSet.Position(typeSpec.Pos())
+
+				message := fmt.Sprintf("VIOLATION: Exported domain struct %s carries no stereotype marker at %s:%d (%s)", typeKey, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, nil, ge.Pin(err)
+	}
+
+	return types, violations, nil
+}
@@ -0,0 +1,91 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindDeepEqualComparisons scans for reflect.DeepEqual calls comparing SomeObjects, e.g. `reflect.DeepEqual(a, b)`
+// with a and b typed heuristically as the same Value Object, see expressionTyper, which is slow and compares
+// every unexported field instead of the equality the type defines. Test files are never walked.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//   - constructors: A map of constructor information, see FindConstructors
+//
+// Returns:
+//   - A map of violation messages to deep equal violations
+//   - An error if the scan fails, nil otherwise
+func FindDeepEqualComparisons(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	constructorTypes := constructorTypeNames(constructors)
+	violations := make(map[string]*Violation)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		reflectAliases := GetPackageAliases(file, "reflect")
+		if len(reflectAliases) == 0 {
+			return
+		}
+
+		typer := newExpressionTyper(file, packages, typeDeclarations, constructorTypes)
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			variables := make(map[string]string)
+			typer.declareParameters(funcDecl, variables)
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				switch node := n.(type) {
+				case *ast.ValueSpec, *ast.AssignStmt:
+					typer.declare(node, variables)
+				case *ast.CallExpr:
+					selector, ok := ast.Unparen(node.Fun).(*ast.SelectorExpr)
+					if !ok || selector.Sel.Name != "DeepEqual" || len(node.Args) != 2 {
+						return true
+					}
+
+					ident, ok := selector.X.(*ast.Ident)
+					if !ok || !isOneOf(ident.Name, reflectAliases) {
+						return true
+					}
+
+					for _, arg := range node.Args {
+						typeKey, ok := typer.typeOf(arg, variables)
+						if !ok {
+							continue
+						}
+
+						position := fileSet.Position(node.Pos())
+
+						message := fmt.Sprintf("VIOLATION: %s %s compared with reflect.DeepEqual in %s at %s:%d (%s)", markerName, typeKey, funcDecl.Name.Name, path, position.Line, checkName)
+						violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+
+						break
+					}
+				}
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
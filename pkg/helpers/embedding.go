@@ -0,0 +1,64 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindEmbeddedTypes scans SomeObject structs for embedded types, e.g. `type Money struct { _ ValueObject; Base }`.
+// An embedded type silently promotes its fields and methods, which may add mutability or identity.
+// The marker is a named "_" field, so it is never reported.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to embedding violations
+//   - An error if the scan fails, nil otherwise
+func FindEmbeddedTypes(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			typeKey := currentPackage + "." + typeSpec.Name.Name
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok || structType.Fields == nil || !typeDeclarations[typeKey] {
+				return true
+			}
+
+			for _, field := range structType.Fields.List {
+				if len(field.Names) != 0 {
+					continue
+				}
+
+				position := fileSet.Position(field.Pos())
+
+				message := fmt.Sprintf("VIOLATION: %s %s embeds %s at %s:%d (%s)", markerName, typeKey, types.ExprString(field.Type), path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
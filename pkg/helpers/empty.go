@@ -0,0 +1,119 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// CountDataFields counts the fields of a struct type that carry data, ignoring
+// every field named after the marker field.
+//
+// Parameters:
+//   - structType: The AST struct type to inspect
+//   - markerField: The name of the marker field, usually "_"
+//
+// Returns:
+//   - The number of non-marker fields
+func CountDataFields(structType *ast.StructType, markerField string) int {
+	if structType.Fields == nil {
+		return 0
+	}
+
+	count := 0
+
+	for _, field := range structType.Fields.List {
+		// Embedded field
+		if len(field.Names) == 0 {
+			count++
+			continue
+		}
+
+		for _, name := range field.Names {
+			if name.Name != markerField {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// FindEmptyTypeDeclarations scans for SomeObject structs whose only field is the marker.
+// It parses rootPath for this scan only, see FindEmptyTypeDeclarationsInWalk to share one parse across scans.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - markerField: The name of the marker field, usually "_"
+//   - isTypeDeclaration: The predicate recognising SomeObject structs
+//
+// Returns:
+//   - A map of violation messages to empty SomeObject declaration violations
+//   - An error if the scan fails, nil otherwise
+func FindEmptyTypeDeclarations(rootPath string, checkName string, markerName string, markerField string, isTypeDeclaration IsTypeDeclaration) (map[string]*Violation, error) {
+	result, err := FindEmptyTypeDeclarationsInWalk(NewOptions().Walker(rootPath), checkName, markerName, markerField, isTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return result, nil
+}
+
+// FindEmptyTypeDeclarationsInWalk scans for SomeObject structs whose only field is the marker.
+// It walks the files of walk, so the scan can share one parse with other scans, see Options.Walker.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - markerField: The name of the marker field, usually "_"
+//   - isTypeDeclaration: The predicate recognising SomeObject structs
+//
+// Returns:
+//   - A map of violation messages to empty SomeObject declaration violations
+//   - An error if the scan fails, nil otherwise
+func FindEmptyTypeDeclarationsInWalk(walk Walker, checkName string, markerName string, markerField string, isTypeDeclaration IsTypeDeclaration) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			if isFunctionNode(n) {
+				return false
+			}
+
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			if !isTypeDeclaration(file, structType) || CountDataFields(structType, markerField) > 0 {
+				return true
+			}
+
+			typeKey := currentPackage + "." + typeSpec.Name.Name
+			position := fileSet.Position(typeSpec.Pos())
+
+			message := fmt.Sprintf("VIOLATION: Empty %s %s has no fields besides the marker at %s:%d (%s)", markerName, typeKey, path, position.Line, checkName)
+			violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
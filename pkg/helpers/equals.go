@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// equalityMethodNames lists the method names recognised as equality comparisons.
+var equalityMethodNames = []string{"Equals", "Equal"}
+
+// FindIncompleteEquals scans Equals and Equal methods of SomeObjects for fields never referenced in the body,
+// e.g. a field added to the type but forgotten in the comparison.
+// Methods referencing no field at all, such as ones comparing whole values, are skipped.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeFields: A map of type names to their field names, see FindTypeFields
+//
+// Returns:
+//   - A map of violation messages to incomplete equality violations
+//   - An error if the scan fails, nil otherwise
+func FindIncompleteEquals(walk Walker, checkName string, markerName string, typeFields map[string][]string) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil || !isOneOf(funcDecl.Name.Name, equalityMethodNames) {
+				continue
+			}
+
+			typeKey, _, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			fields := typeFields[typeKey]
+
+			if !ok || len(fields) == 0 {
+				continue
+			}
+
+			referenced := make(map[string]bool)
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				if selector, ok := n.(*ast.SelectorExpr); ok && isOneOf(selector.Sel.Name, fields) {
+					referenced[selector.Sel.Name] = true
+				}
+
+				return true
+			})
+
+			if len(referenced) == 0 {
+				continue
+			}
+
+			var missing []string
+
+			for _, field := range fields {
+				if !referenced[field] {
+					missing = append(missing, field)
+				}
+			}
+
+			if len(missing) == 0 {
+				continue
+			}
+
+			position := fileSet.Position(funcDecl.Pos())
+
+			message := fmt.Sprintf("VIOLATION: %s %s %s does not compare fields %s at %s:%d (%s)", markerName, typeKey, funcDecl.Name.Name, strings.Join(missing, ", "), path, position.Line, checkName)
+			violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,22 @@
+package helpers
+
+import (
+	"errors"
+)
+
+var (
+	// ErrProjectRootNotFound is returned when no directory containing go.mod can be located.
+	ErrProjectRootNotFound = errors.New("cannot find project root")
+
+	// ErrScanFailed is returned when walking the project directory fails.
+	ErrScanFailed = errors.New("project scan failed")
+
+	// ErrInvalidConfig is returned when a configuration file cannot be read or contains invalid values.
+	ErrInvalidConfig = errors.New("invalid configuration")
+
+	// ErrEntrypointNotFound is returned when no scanned file belongs to the entrypoint package of a reachability scope.
+	ErrEntrypointNotFound = errors.New("entrypoint package not found")
+
+	// ErrModulePathNotFound is returned when no go.mod file declaring a module path can be located.
+	ErrModulePathNotFound = errors.New("cannot find module path")
+)
@@ -0,0 +1,70 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// IsErrorMethod reports whether a method has the signature of error's method, Error() string.
+//
+// Parameters:
+//   - funcDecl: The method declaration
+//
+// Returns:
+//   - true if the method is named Error, takes no parameters and returns a single string, false otherwise
+func IsErrorMethod(funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Name.Name != "Error" || funcDecl.Type.Params.NumFields() != 0 || funcDecl.Type.Results.NumFields() != 1 {
+		return false
+	}
+
+	ident, ok := ast.Unparen(funcDecl.Type.Results.List[0].Type).(*ast.Ident)
+
+	return ok && ident.Name == "string"
+}
+
+// FindErrorImplementations scans for SomeObjects with an Error() string method,
+// which makes them satisfy the error interface, so errors.As and type switches may swallow them.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//   - allowed: A map of type names allowed to implement error
+//
+// Returns:
+//   - A map of violation messages to error implementation violations
+//   - An error if the scan fails, nil otherwise
+func FindErrorImplementations(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool, allowed map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || !IsErrorMethod(funcDecl) {
+				continue
+			}
+
+			typeKey, _, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			if !ok || !typeDeclarations[typeKey] || allowed[typeKey] {
+				continue
+			}
+
+			position := fileSet.Position(funcDecl.Pos())
+
+			message := fmt.Sprintf("VIOLATION: %s %s implements the error interface at %s:%d (%s)", markerName, typeKey, path, position.Line, checkName)
+			violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,120 @@
+package helpers
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// constructorTypeNames maps package-qualified constructor names, e.g. "money.NewMoney", to the types they construct.
+func constructorTypeNames(constructors map[string]*ConstructorInfo) map[string]string {
+	constructorTypes := make(map[string]string, len(constructors))
+
+	for _, constructor := range constructors {
+		typePackage, _ := SplitTypeKey(constructor.TypeName)
+		constructorTypes[typePackage+"."+constructor.Name] = constructor.TypeName
+	}
+
+	return constructorTypes
+}
+
+// expressionTyper resolves the SomeObject type of expressions of a file heuristically, without type checking.
+// An expression has a type when it is a composite literal, a call of a constructor, or a variable
+// or parameter declared with the type or assigned one of them, pointers included.
+type expressionTyper struct {
+	file             *ast.File
+	currentPackage   string
+	packages         PackageIndex
+	typeDeclarations map[string]bool
+	constructorTypes map[string]string
+}
+
+// newExpressionTyper returns an expressionTyper for the SomeObject types of a file.
+func newExpressionTyper(file *ast.File, packages PackageIndex, typeDeclarations map[string]bool, constructorTypes map[string]string) *expressionTyper {
+	return &expressionTyper{
+		file:             file,
+		currentPackage:   file.Name.Name,
+		packages:         packages,
+		typeDeclarations: typeDeclarations,
+		constructorTypes: constructorTypes,
+	}
+}
+
+// typeOf resolves the SomeObject type of an expression from its syntax and the variables seen so far.
+func (t *expressionTyper) typeOf(expr ast.Expr, variables map[string]string) (string, bool) {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			return t.typeOf(e.X, variables)
+		}
+	case *ast.StarExpr:
+		return t.typeOf(e.X, variables)
+	case *ast.CompositeLit:
+		if e.Type != nil {
+			return t.typeOf(e.Type, variables)
+		}
+	case *ast.CallExpr:
+		if function, ok := resolveTypeKey(t.file, t.currentPackage, t.packages, e.Fun); ok && t.constructorTypes[function] != "" {
+			return t.constructorTypes[function], true
+		}
+	case *ast.Ident:
+		if typeKey, ok := variables[e.Name]; ok {
+			return typeKey, true
+		}
+	}
+
+	typeKey, ok := resolveTypeKey(t.file, t.currentPackage, t.packages, expr)
+
+	return typeKey, ok && t.typeDeclarations[typeKey]
+}
+
+// declareParameters records the receiver and parameters of a function declared with a SomeObject type.
+func (t *expressionTyper) declareParameters(funcDecl *ast.FuncDecl, variables map[string]string) {
+	for _, fieldList := range []*ast.FieldList{funcDecl.Recv, funcDecl.Type.Params} {
+		if fieldList == nil {
+			continue
+		}
+
+		for _, field := range fieldList.List {
+			if typeKey, ok := t.typeOf(field.Type, variables); ok {
+				for _, name := range field.Names {
+					variables[name.Name] = typeKey
+				}
+			}
+		}
+	}
+}
+
+// declare records the variables a var declaration or an assignment gives a SomeObject type.
+func (t *expressionTyper) declare(node ast.Node, variables map[string]string) {
+	switch node := node.(type) {
+	case *ast.ValueSpec:
+		for i, name := range node.Names {
+			if node.Type != nil {
+				if typeKey, ok := t.typeOf(node.Type, variables); ok {
+					variables[name.Name] = typeKey
+				}
+			} else if i < len(node.Values) {
+				if typeKey, ok := t.typeOf(node.Values[i], variables); ok {
+					variables[name.Name] = typeKey
+				}
+			}
+		}
+	case *ast.AssignStmt:
+		// Constructors may return an error besides the value, x, err := NewX(...)
+		if len(node.Rhs) == 1 && len(node.Lhs) > 1 {
+			if ident, ok := node.Lhs[0].(*ast.Ident); ok {
+				if typeKey, ok := t.typeOf(node.Rhs[0], variables); ok {
+					variables[ident.Name] = typeKey
+				}
+			}
+		}
+
+		for i := 0; i < len(node.Lhs) && len(node.Lhs) == len(node.Rhs); i++ {
+			if ident, ok := node.Lhs[i].(*ast.Ident); ok {
+				if typeKey, ok := t.typeOf(node.Rhs[i], variables); ok {
+					variables[ident.Name] = typeKey
+				}
+			}
+		}
+	}
+}
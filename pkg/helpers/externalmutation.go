@@ -0,0 +1,111 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// mutatedField returns the SomeObject type and the field an assignment target writes to, looking through
+// field selectors, index expressions and dereferences, e.g. Order and "items" for `order.items[0].qty = 2`.
+func mutatedField(typer *expressionTyper, target ast.Expr, variables map[string]string) (string, string, bool) {
+	for {
+		switch e := ast.Unparen(target).(type) {
+		case *ast.SelectorExpr:
+			if typeKey, ok := typer.typeOf(e.X, variables); ok {
+				return typeKey, e.Sel.Name, true
+			}
+
+			target = e.X
+		case *ast.IndexExpr:
+			target = e.X
+		case *ast.StarExpr:
+			target = e.X
+		default:
+			return "", "", false
+		}
+	}
+}
+
+// FindExternalMutations scans functions for assignments to fields of SomeObjects outside the methods of their type,
+// e.g. `order.status = Shipped` in an application service, which bypass the invariants the methods enforce.
+// Variables are typed heuristically, see expressionTyper, assignments inside constructors of the type are allowed,
+// pointer-returning ones such as `func NewCart(id string) *Cart` included.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//   - constructors: A map of constructor information, see FindConstructors
+//
+// Returns:
+//   - A map of violation messages to external mutation violations
+//   - An error if the scan fails, nil otherwise
+func FindExternalMutations(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	constructorTypes := constructorTypeNames(constructors)
+	violations := make(map[string]*Violation)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		typer := newExpressionTyper(file, packages, typeDeclarations, constructorTypes)
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			receiverType, _, _ := ReceiverTypeKey(typer.currentPackage, funcDecl)
+
+			variables := make(map[string]string)
+			typer.declareParameters(funcDecl, variables)
+
+			report := func(target ast.Expr) {
+				typeKey, field, ok := mutatedField(typer, target, variables)
+				if !ok || typeKey == receiverType {
+					return
+				}
+
+				position := fileSet.Position(target.Pos())
+				if IsInsideConstructor(path, position.Line, typeKey, constructors) {
+					return
+				}
+
+				message := fmt.Sprintf("VIOLATION: Field %s of %s %s is assigned outside its methods in %s at %s:%d (%s)", field, markerName, typeKey, funcDecl.Name.Name, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+			}
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				switch stmt := n.(type) {
+				case *ast.ValueSpec:
+					typer.declare(stmt, variables)
+				case *ast.AssignStmt:
+					if stmt.Tok != token.DEFINE {
+						for _, target := range stmt.Lhs {
+							report(target)
+						}
+					}
+
+					typer.declare(stmt, variables)
+				case *ast.IncDecStmt:
+					report(stmt.X)
+				}
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,36 @@
+package helpers
+
+import (
+	"testing"
+)
+
+const aggregatePackage = "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/aggregate"
+
+func TestFindExternalMutationsAllowsPointerConstructors(t *testing.T) {
+	walk := NewWalker("testdata/externalmutation")
+
+	types, err := FindTypeDeclarationsInWalk(walk, NewOptions().TypeDeclaration(aggregatePackage, "_", "AggregateRoot"))
+	if err != nil {
+		t.Fatalf("FindTypeDeclarationsInWalk() error = %v", err)
+	}
+
+	constructors, err := FindConstructorsInWalk(walk, types)
+	if err != nil {
+		t.Fatalf("FindConstructorsInWalk() error = %v", err)
+	}
+
+	violations, err := FindExternalMutations(walk, CheckAggregateExternalMutation, "AggregateRoot", types, constructors)
+	if err != nil {
+		t.Fatalf("FindExternalMutations() error = %v", err)
+	}
+
+	if len(violations) != 1 {
+		t.Fatalf("FindExternalMutations() found %d violations, want 1: %v", len(violations), violations)
+	}
+
+	for _, violation := range violations {
+		if violation.File != "testdata/externalmutation/checkout/checkout.go" {
+			t.Errorf("violation file = %q, want the checkout service", violation.File)
+		}
+	}
+}
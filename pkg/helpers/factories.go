@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"go/ast"
+	"go/token"
+	"sort"
+)
+
+// FactoryTypeKeys returns the SomeObject types a factory function builds when its result type,
+// typically an interface of a port, hides the concrete type. The types are taken from the composite literals
+// the function's own return statements return first, e.g. `return &postgresRepository{db: db}, nil`;
+// values returned through variables or other calls are not followed.
+//
+// Parameters:
+//   - file: The AST file declaring the function
+//   - currentPackage: The package name of the file
+//   - funcDecl: The factory function declaration
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - The sorted "package.TypeName" keys of the returned SomeObjects, nil if none is returned
+func FactoryTypeKeys(file *ast.File, currentPackage string, funcDecl *ast.FuncDecl, typeDeclarations map[string]bool) []string {
+	if funcDecl.Body == nil {
+		return nil
+	}
+
+	found := make(map[string]bool)
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			// Returns of nested function literals do not return from the factory
+			return false
+		case *ast.ReturnStmt:
+			if len(node.Results) == 0 {
+				return true
+			}
+
+			result := ast.Unparen(node.Results[0])
+
+			if unary, ok := result.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+				result = ast.Unparen(unary.X)
+			}
+
+			compositeLit, ok := result.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+
+			// Constructors build types of their own package
+			if _, ok := compositeLit.Type.(*ast.Ident); !ok {
+				return true
+			}
+
+			if typeKey, ok := resolveTypeKey(file, currentPackage, nil, compositeLit.Type); ok && typeDeclarations[typeKey] {
+				found[typeKey] = true
+			}
+		}
+
+		return true
+	})
+
+	var typeKeys []string
+
+	for typeKey := range found {
+		typeKeys = append(typeKeys, typeKey)
+	}
+
+	sort.Strings(typeKeys)
+
+	return typeKeys
+}
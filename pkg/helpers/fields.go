@@ -0,0 +1,125 @@
+package helpers
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FieldNames lists the data field names of a struct type, ignoring the marker field.
+// Embedded fields are named after their type.
+//
+// Parameters:
+//   - structType: The AST struct type to inspect
+//   - markerField: The name of the marker field, usually "_"
+//
+// Returns:
+//   - The field names in declaration order
+func FieldNames(structType *ast.StructType, markerField string) []string {
+	var names []string
+
+	if structType.Fields == nil {
+		return names
+	}
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			if name := embeddedFieldName(field.Type); name != "" {
+				names = append(names, name)
+			}
+
+			continue
+		}
+
+		for _, name := range field.Names {
+			if name.Name != markerField {
+				names = append(names, name.Name)
+			}
+		}
+	}
+
+	return names
+}
+
+// embeddedFieldName returns the implicit field name of an embedded type.
+func embeddedFieldName(typeExpr ast.Expr) string {
+	switch typ := typeExpr.(type) {
+	case *ast.Ident:
+		return typ.Name
+	case *ast.SelectorExpr:
+		return typ.Sel.Name
+	case *ast.StarExpr:
+		return embeddedFieldName(typ.X)
+	case *ast.IndexExpr:
+		return embeddedFieldName(typ.X)
+	case *ast.IndexListExpr:
+		return embeddedFieldName(typ.X)
+	default:
+		return ""
+	}
+}
+
+// FindTypeFields collects the data field names of the given SomeObject types.
+// It parses rootPath for this scan only, see FindTypeFieldsInWalk to share one parse across scans.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - typeDeclarations: A map of SomeObjects type names
+//   - markerField: The name of the marker field, usually "_"
+//
+// Returns:
+//   - A map of type names to their field names
+//   - An error if the scan fails, nil otherwise
+func FindTypeFields(rootPath string, typeDeclarations map[string]bool, markerField string) (map[string][]string, error) {
+	result, err := FindTypeFieldsInWalk(NewOptions().Walker(rootPath), typeDeclarations, markerField)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return result, nil
+}
+
+// FindTypeFieldsInWalk collects the data field names of the given SomeObject types.
+// It walks the files of walk, so the scan can share one parse with other scans, see Options.Walker.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - typeDeclarations: A map of SomeObjects type names
+//   - markerField: The name of the marker field, usually "_"
+//
+// Returns:
+//   - A map of type names to their field names
+//   - An error if the scan fails, nil otherwise
+func FindTypeFieldsInWalk(walk Walker, typeDeclarations map[string]bool, markerField string) (map[string][]string, error) {
+	typeFields := make(map[string][]string)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			typeKey := currentPackage + "." + typeSpec.Name.Name
+			if typeDeclarations[typeKey] {
+				typeFields[typeKey] = FieldNames(structType, markerField)
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return typeFields, nil
+}
@@ -0,0 +1,114 @@
+package helpers
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FormatStyle selects how FormatViolation renders a violation.
+type FormatStyle int
+
+const (
+	// FormatProse renders the violation message as reported, e.g.
+	// "VIOLATION: Direct zero-value initialization of ValueObject money.Money at money/money.go:12".
+	FormatProse FormatStyle = iota
+	// FormatCompiler renders the violation in compiler style, e.g.
+	// "money/money.go:12:9: [zero-value-initialization] Direct zero-value initialization of ValueObject money.Money",
+	// which editors and CI annotators recognise. The column is left out for violations without one, see Violation.Column.
+	FormatCompiler
+)
+
+// FormatOptions configures FormatViolation.
+//
+// Fields:
+//   - Style: The rendering style, FormatProse by default
+//   - RelativeTo: The base path the file is rendered relative to, both resolved against the working directory,
+//     the file is rendered as is when empty
+//   - SourceRoot: The directory relative violation files are read from for snippets, the working directory when empty
+//   - Snippet: Whether to append the offending source line, indented by a tab
+type FormatOptions struct {
+	Style      FormatStyle
+	RelativeTo string
+	SourceRoot string
+	Snippet    bool
+}
+
+// FormatViolation renders a single violation as a diagnostic string,
+// the same way the dddgo command prints it.
+//
+// Parameters:
+//   - v: The violation to render
+//   - opts: The rendering options
+//
+// Returns:
+//   - The rendered violation, spanning two lines with a snippet
+func FormatViolation(v Violation, opts FormatOptions) string {
+	file := v.File
+	if opts.RelativeTo != "" {
+		file = filepath.ToSlash(RelativePath(opts.RelativeTo, v.File))
+	}
+
+	var diagnostic string
+
+	switch opts.Style {
+	case FormatCompiler:
+		location := fmt.Sprintf("%s:%d", file, v.Line)
+		if v.Column > 0 {
+			location += fmt.Sprintf(":%d", v.Column)
+		}
+
+		diagnostic = fmt.Sprintf("%s: [%s] %s", location, v.Check, violationDescription(v))
+	default:
+		diagnostic = strings.Replace(v.Message, v.File+":", file+":", 1)
+	}
+
+	if !opts.Snippet {
+		return diagnostic
+	}
+
+	source := v.File
+	if opts.SourceRoot != "" && !filepath.IsAbs(source) {
+		source = filepath.Join(opts.SourceRoot, source)
+	}
+
+	if line, ok := sourceLine(source, v.Line); ok {
+		diagnostic += "\n\t" + strings.TrimSpace(line)
+	}
+
+	return diagnostic
+}
+
+// violationDescription strips the "VIOLATION: " prefix and the trailing location and check of a violation message.
+func violationDescription(v Violation) string {
+	description := strings.TrimPrefix(v.Message, "VIOLATION: ")
+	description = strings.TrimSuffix(description, fmt.Sprintf(" (%s)", v.Check))
+
+	location := fmt.Sprintf(" at %s:%d", v.File, v.Line)
+	if index := strings.LastIndex(description, location); index >= 0 {
+		description = description[:index] + description[index+len(location):]
+	}
+
+	return description
+}
+
+// sourceLine reads a 1-based line of a file.
+func sourceLine(path string, line int) (string, bool) {
+	file, err := os.Open(filepath.FromSlash(path))
+	if err != nil {
+		return "", false
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for current := 1; scanner.Scan(); current++ {
+		if current == line {
+			return scanner.Text(), true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,45 @@
+package helpers
+
+import (
+	"testing"
+)
+
+func TestFormatViolationCompilerStyle(t *testing.T) {
+	options := NewOptions()
+	walk := options.Walker("testdata/qualify")
+
+	types, err := FindTypeDeclarationsInWalk(walk, options.TypeDeclaration(valueObjectPackage, "_", "ValueObject"))
+	if err != nil {
+		t.Fatalf("FindTypeDeclarationsInWalk() error = %v", err)
+	}
+
+	constructors, err := FindConstructorsInWalk(walk, types)
+	if err != nil {
+		t.Fatalf("FindConstructorsInWalk() error = %v", err)
+	}
+
+	violations, err := FindZeroValueInitializationsInWalk(walk, "ValueObject", types, constructors)
+	if err != nil {
+		t.Fatalf("FindZeroValueInitializationsInWalk() error = %v", err)
+	}
+
+	if len(violations) != 1 {
+		t.Fatalf("FindZeroValueInitializationsInWalk() = %v, want 1 violation", violations)
+	}
+
+	for _, violation := range violations {
+		got := FormatViolation(*violation, FormatOptions{Style: FormatCompiler, RelativeTo: "testdata/qualify"})
+		want := "internal/app/checkout.go:9:9: [zero-value-initialization] Direct zero-value initialization of ValueObject orders.Money"
+
+		if got != want {
+			t.Errorf("FormatViolation() = %q, want %q", got, want)
+		}
+	}
+
+	// Violations located by line only render without a column
+	lineOnly := NewViolation(CheckNoConstructor, "orders.Money", "orders/money.go", 7, "VIOLATION: ValueObject orders.Money has no constructor at orders/money.go:7 (no-constructor)")
+
+	if got, want := FormatViolation(*lineOnly, FormatOptions{Style: FormatCompiler}), "orders/money.go:7: [no-constructor] ValueObject orders.Money has no constructor"; got != want {
+		t.Errorf("FormatViolation() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,161 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// importNames returns the local names of the packages a file imports.
+func importNames(file *ast.File) map[string]bool {
+	names := make(map[string]bool)
+
+	for _, imp := range file.Imports {
+		importPath := strings.Trim(imp.Path.Value, `"`)
+
+		name := importPath[strings.LastIndex(importPath, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+
+		if name != "_" && name != "." {
+			names[name] = true
+		}
+	}
+
+	return names
+}
+
+// assignedGlobal returns the package-level variable an assignment target writes to, looking through
+// field selectors, index expressions and dereferences, e.g. "cache" for `cache[key] = value`
+// or "metrics.Calls" for `metrics.Calls++`.
+func assignedGlobal(target ast.Expr, file *ast.File, imports map[string]bool, packageVars map[string]bool) (string, bool) {
+	for {
+		switch e := ast.Unparen(target).(type) {
+		case *ast.Ident:
+			// Identifiers declared in the same file resolve to their declaration, ones of other files
+			// of the package stay unresolved, locals, parameters and receivers always resolve
+			if e.Obj != nil {
+				return e.Name, e.Obj.Kind == ast.Var && file.Scope.Lookup(e.Name) == e.Obj
+			}
+
+			return e.Name, packageVars[e.Name]
+		case *ast.SelectorExpr:
+			if ident, ok := ast.Unparen(e.X).(*ast.Ident); ok && ident.Obj == nil && imports[ident.Name] && !packageVars[ident.Name] {
+				return ident.Name + "." + e.Sel.Name, true
+			}
+
+			target = e.X
+		case *ast.IndexExpr:
+			target = e.X
+		case *ast.StarExpr:
+			target = e.X
+		default:
+			return "", false
+		}
+	}
+}
+
+// FindGlobalMutations scans the methods of SomeObjects for assignments to package-level variables,
+// of their own package or an imported one, e.g. `lastSeen = m` or `cache[m.code] = m`,
+// hidden side effects of methods that look pure.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to global mutation violations
+//   - An error if the scan fails, nil otherwise
+func FindGlobalMutations(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	// package directory -> package-level variable names
+	packageVars := make(map[string]map[string]bool)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		dir := filepath.Dir(path)
+
+		if packageVars[dir] == nil {
+			packageVars[dir] = make(map[string]bool)
+		}
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				for _, name := range spec.(*ast.ValueSpec).Names {
+					if name.Name != "_" {
+						packageVars[dir][name.Name] = true
+					}
+				}
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	violations := make(map[string]*Violation)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+		imports := importNames(file)
+		vars := packageVars[filepath.Dir(path)]
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			typeKey, _, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			if !ok || !typeDeclarations[typeKey] {
+				continue
+			}
+
+			report := func(target ast.Expr) {
+				global, ok := assignedGlobal(target, file, imports, vars)
+				if !ok {
+					return
+				}
+
+				position := fileSet.Position(target.Pos())
+
+				message := fmt.Sprintf("VIOLATION: Method %s of %s %s assigns package-level variable %s at %s:%d (%s)", funcDecl.Name.Name, markerName, typeKey, global, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+			}
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				switch stmt := n.(type) {
+				case *ast.AssignStmt:
+					if stmt.Tok == token.DEFINE {
+						return true
+					}
+
+					for _, target := range stmt.Lhs {
+						report(target)
+					}
+				case *ast.IncDecStmt:
+					report(stmt.X)
+				}
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
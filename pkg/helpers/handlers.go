@@ -0,0 +1,146 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// HandlerInfo contains location information about a function handling a SomeObject.
+type HandlerInfo struct {
+	Name string
+	File string
+	Line int
+}
+
+// FindHandlers scans for functions and methods taking a SomeObject as a parameter, e.g.
+// func (h *Handler) Handle(ctx context.Context, cmd PlaceOrder) error.
+// Constructors of the SomeObject itself are not handlers.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of type names to the handlers accepting them
+//   - An error if the scan fails, nil otherwise
+func FindHandlers(walk Walker, typeDeclarations map[string]bool) (map[string][]*HandlerInfo, error) {
+	handlers := make(map[string][]*HandlerInfo)
+
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Type.Params == nil {
+				continue
+			}
+
+			constructedType, _ := ConstructedTypeKey(currentPackage, funcDecl)
+			handled := make(map[string]bool)
+
+			for _, param := range funcDecl.Type.Params.List {
+				paramType := ast.Unparen(param.Type)
+				if star, ok := paramType.(*ast.StarExpr); ok {
+					paramType = star.X
+				}
+
+				typeKey, ok := resolveTypeKey(file, currentPackage, packages, paramType)
+				if !ok || !typeDeclarations[typeKey] || typeKey == constructedType || handled[typeKey] {
+					continue
+				}
+
+				handled[typeKey] = true
+				handlers[typeKey] = append(handlers[typeKey], &HandlerInfo{
+					Name: funcDecl.Name.Name,
+					File: path,
+					Line: fileSet.Position(funcDecl.Pos()).Line,
+				})
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return handlers, nil
+}
+
+// BoundedContext returns the bounded context a file belongs to,
+// which is the first directory below contextRoot on the way to the file.
+//
+// Parameters:
+//   - contextRoot: The directory whose subdirectories are bounded contexts
+//   - file: The file path
+//
+// Returns:
+//   - The bounded context directory name, empty string if the file is not inside a bounded context
+func BoundedContext(contextRoot string, file string) string {
+	relative := filepath.ToSlash(RelativePath(contextRoot, file))
+	if relative == filepath.ToSlash(file) || strings.HasPrefix(relative, "../") {
+		return ""
+	}
+
+	context, _, found := strings.Cut(relative, "/")
+	if !found {
+		return ""
+	}
+
+	return context
+}
+
+// FindCrossContextHandlers reports SomeObjects that are handled, but only from other bounded contexts.
+// SomeObjects without any handler are not reported.
+//
+// Parameters:
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - contextRoot: The directory whose subdirectories are bounded contexts
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//   - handlers: A map of type names to their handlers, see FindHandlers
+//
+// Returns:
+//   - A map of violation messages to cross-context handler violations
+func FindCrossContextHandlers(checkName string, markerName string, contextRoot string, locations map[string]*TypeLocation, handlers map[string][]*HandlerInfo) map[string]*Violation {
+	violations := make(map[string]*Violation)
+
+	for typeKey, location := range locations {
+		typeHandlers := handlers[typeKey]
+		if len(typeHandlers) == 0 {
+			continue
+		}
+
+		context := BoundedContext(contextRoot, location.File)
+		contexts := make([]string, 0, len(typeHandlers))
+		sameContext := false
+
+		for _, handler := range typeHandlers {
+			handlerContext := BoundedContext(contextRoot, handler.File)
+			if handlerContext == context {
+				sameContext = true
+				break
+			}
+
+			contexts = append(contexts, handlerContext)
+		}
+
+		if sameContext {
+			continue
+		}
+
+		message := fmt.Sprintf("VIOLATION: %s %s of context %q is only handled from contexts %q at %s:%d (%s)", markerName, typeKey, context, contexts, location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations
+}
@@ -3,7 +3,6 @@ package helpers
 import (
 	"fmt"
 	"go/ast"
-	"go/parser"
 	"go/token"
 	"os"
 	"path/filepath"
@@ -24,6 +23,8 @@ import (
 type IsTypeDeclaration func(file *ast.File, structType *ast.StructType) bool
 
 // IsSomeObjectTypeDeclaration checks if a struct type contains the SomeObject marker field named "_".
+// A marker embedded by pointer is accepted too, so the type stays under validation;
+// it is reported separately by FindPointerMarkers.
 //
 // Parameters:
 //   - file: The AST file to check imports from
@@ -36,38 +37,80 @@ func IsSomeObjectTypeDeclaration(file *ast.File, structType *ast.StructType, ful
 		return false
 	}
 
-	pkgAlias := GetPackageAlias(file, fullPackage)
-	if pkgAlias == "" {
+	pkgAliases := GetPackageAliases(file, fullPackage)
+	if len(pkgAliases) == 0 {
 		return false
 	}
 
 	for _, field := range structType.Fields.List {
-		// STRICT CHECK: Only fields explicitly named "_" are considered SomeObject markers
-		if len(field.Names) == 1 && field.Names[0].Name == markerField {
-			if selector, ok := field.Type.(*ast.SelectorExpr); ok {
-				if ident, ok := selector.X.(*ast.Ident); ok {
-					if ident.Name == pkgAlias && selector.Sel.Name == declaredName {
-						return true
-					}
-				}
-			}
+		if isMarker, _ := IsSomeObjectMarkerField(field, pkgAliases, markerField, declaredName); isMarker {
+			return true
 		}
 	}
 
 	return false
 }
 
+// IsSomeObjectMarkerField checks if a struct field is the SomeObject marker.
+//
+// Parameters:
+//   - field: The AST struct field to check
+//   - pkgAliases: The aliases the marker package is imported under
+//   - markerField: The name of the marker field, usually "_"
+//   - declaredName: The marker type name
+//
+// Returns:
+//   - true if the field is the marker, false otherwise
+//   - true if the marker is declared as a pointer, false otherwise
+func IsSomeObjectMarkerField(field *ast.Field, pkgAliases []string, markerField string, declaredName string) (bool, bool) {
+	// STRICT CHECK: Only fields explicitly named "_" are considered SomeObject markers
+	if len(field.Names) != 1 || field.Names[0].Name != markerField {
+		return false, false
+	}
+
+	fieldType := ast.Unparen(field.Type)
+	isPointer := false
+
+	if star, ok := fieldType.(*ast.StarExpr); ok {
+		fieldType = ast.Unparen(star.X)
+		isPointer = true
+	}
+
+	selector, ok := fieldType.(*ast.SelectorExpr)
+	if !ok {
+		return false, false
+	}
+
+	ident, ok := selector.X.(*ast.Ident)
+	if !ok {
+		return false, false
+	}
+
+	if selector.Sel.Name != declaredName || !isOneOf(ident.Name, pkgAliases) {
+		return false, false
+	}
+
+	// The alias is shadowed by a local declaration, e.g. a variable named like the package,
+	// so the selector does not refer to the imported marker package.
+	// Imported package names are never resolved by the parser, so their Obj is nil.
+	if ident.Obj != nil {
+		return false, false
+	}
+
+	return true, isPointer
+}
+
 // FindProjectRoot attempts to locate the root directory of the current Go project.
 // It traverses up the directory tree starting from the caller's file location
 // until it finds a directory containing a go.mod file.
 //
 // Returns:
 //   - The absolute path to the project root directory if found
-//   - An empty string if the project root cannot be located
+//   - An empty string and an error wrapping ErrProjectRootNotFound if the project root cannot be located
 func FindProjectRoot() (string, error) {
 	_, filename, _, ok := runtime.Caller(1)
 	if !ok {
-		return "", ge.New("cannot find project root")
+		return "", ge.Pin(ErrProjectRootNotFound)
 	}
 
 	current := filepath.Dir(filename)
@@ -86,10 +129,11 @@ func FindProjectRoot() (string, error) {
 		current = parent
 	}
 
-	return "", ge.New("cannot find project root")
+	return "", ge.Pin(ErrProjectRootNotFound)
 }
 
 // GetPackageAlias finds the package alias for a given full package path in the file's imports.
+// If the same path is imported several times, the first alias is returned.
 //
 // Parameters:
 //   - file: The AST file to check imports from
@@ -98,22 +142,56 @@ func FindProjectRoot() (string, error) {
 // Returns:
 //   - The package alias if found, empty string otherwise
 func GetPackageAlias(file *ast.File, fullPackagePath string) string {
+	aliases := GetPackageAliases(file, fullPackagePath)
+	if len(aliases) == 0 {
+		return ""
+	}
+
+	return aliases[0]
+}
+
+// GetPackageAliases finds all package aliases for a given full package path in the file's imports.
+// A file may legally import the same path more than once under different names.
+// An import without an alias goes by the package name, see PackageName.
+//
+// Parameters:
+//   - file: The AST file to check imports from
+//   - fullPackagePath: The full package path to look for
+//
+// Returns:
+//   - The package aliases in import order, nil if the path is not imported
+func GetPackageAliases(file *ast.File, fullPackagePath string) []string {
+	var aliases []string
+
 	for _, imp := range file.Imports {
 		importPath := strings.Trim(imp.Path.Value, `"`)
 
 		if importPath == fullPackagePath {
 			if imp.Name != nil {
-				return imp.Name.Name
+				aliases = append(aliases, imp.Name.Name)
+				continue
 			}
 
-			parts := strings.Split(fullPackagePath, "/")
-			return parts[len(parts)-1] // "valueobject"
+			aliases = append(aliases, PackageName(fullPackagePath))
 		}
 	}
-	return ""
+
+	return aliases
+}
+
+// isOneOf reports whether value is present in list.
+func isOneOf(value string, list []string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
 }
 
 // FindTypeDeclarations scans the project directory for SomeObject type declarations.
+// It parses rootPath for this scan only, see FindTypeDeclarationsInWalk to share one parse across scans.
 //
 // Parameters:
 //   - rootPath: The root directory path to scan for Go files
@@ -122,34 +200,64 @@ func GetPackageAlias(file *ast.File, fullPackagePath string) string {
 //   - A map of SomeObject type names to boolean values indicating their presence
 //   - An error if the scan fails, nil otherwise
 func FindTypeDeclarations(rootPath string, isTypeDeclaration IsTypeDeclaration) (map[string]bool, error) {
-	typeDeclarations := make(map[string]bool)
+	result, err := FindTypeDeclarationsInWalk(NewOptions().Walker(rootPath), isTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
 
-	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil || filepath.Ext(path) != ".go" {
-			return nil
-		}
+	return result, nil
+}
 
-		// Skip test files - we intentionally allow zero-value initializations in tests
-		// to provide flexibility for testing scenarios that don't require full domain validation
-		if strings.HasSuffix(path, "_test.go") {
-			return nil
-		}
+// FindTypeDeclarationsInWalk scans the project directory for SomeObject type declarations.
+// It walks the files of walk, so the scan can share one parse with other scans, see Options.Walker.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//
+// Returns:
+//   - A map of SomeObject type names to boolean values indicating their presence
+//   - An error if the scan fails, nil otherwise
+func FindTypeDeclarationsInWalk(walk Walker, isTypeDeclaration IsTypeDeclaration) (map[string]bool, error) {
+	typeDeclarations, _, err := FindTypeDeclarationsWithChecks(walk, "", isTypeDeclaration, nil)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
 
-		fileSet := token.NewFileSet()
-		file, err := parser.ParseFile(fileSet, path, nil, parser.ParseComments)
-		if err != nil {
-			return nil
-		}
+	return typeDeclarations, nil
+}
+
+// FindTypeDeclarationsWithChecks scans the project directory for SomeObject type declarations,
+// running the custom type checks on each of them in the same pass, see WithTypeCheck.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - markerName: The SomeObject marker name passed to the checks as the stereotype
+//   - isTypeDeclaration: The predicate recognising SomeObject structs
+//   - typeChecks: The custom type checks to run
+//
+// Returns:
+//   - A map of SomeObject type names to boolean values indicating their presence
+//   - A map of violation messages to the violations reported by the checks
+//   - An error if the scan fails, nil otherwise
+func FindTypeDeclarationsWithChecks(walk Walker, markerName string, isTypeDeclaration IsTypeDeclaration, typeChecks []TypeCheckHook) (map[string]bool, map[string]*Violation, error) {
+	typeDeclarations := make(map[string]bool)
+	violations := make(map[string]*Violation)
 
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
 		currentPackage := file.Name.Name
 
 		ast.Inspect(file, func(n ast.Node) bool {
+			// Local types are reported by FindLocalTypeDeclarations, keying them by package would mis-attribute violations
+			if isFunctionNode(n) {
+				return false
+			}
+
 			typeSpec, ok := n.(*ast.TypeSpec)
 			if !ok {
 				return true
 			}
 
-			structType, ok := typeSpec.Type.(*ast.StructType)
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
 			if !ok {
 				return true
 			}
@@ -157,29 +265,56 @@ func FindTypeDeclarations(rootPath string, isTypeDeclaration IsTypeDeclaration)
 			if isTypeDeclaration(file, structType) {
 				typeKey := currentPackage + "." + typeSpec.Name.Name
 				typeDeclarations[typeKey] = true
+
+				runTypeChecks(violations, typeChecks, TypeCheckContext{
+					Path:       path,
+					File:       file,
+					TypeSpec:   typeSpec,
+					StructType: structType,
+					FileSet:    fileSet,
+					Stereotype: markerName,
+					TypeKey:    typeKey,
+				})
 			}
 
 			return true
 		})
-
-		return nil
 	})
 
 	if err != nil {
-		return nil, ge.Pin(err)
+		return nil, nil, ge.Pin(err)
 	}
 
-	return typeDeclarations, nil
+	return typeDeclarations, violations, nil
 }
 
 // ConstructorInfo contains location information about a SomeObjects constructor function.
+// Name is the function name, TypeName the constructed type, qualified with the import path by NewReport,
+// and Package the import path of the declaring package, or its name when no go.mod file is found.
+// Closures lists the line ranges of function literals nested in the constructor body.
+// Copy is set for copy and transform constructors, see CopyConstructedTypeKey, which need an existing value
+// and so do not count as constructors of their type for the no-constructor and related checks.
+// Pointer is set for constructors returning a pointer to their type, such as `func NewOrder() *Order`.
 type ConstructorInfo struct {
-	File      string
-	StartLine int
-	EndLine   int
+	Name      string       `json:"name"`
+	Package   string       `json:"package"`
+	TypeName  string       `json:"typeName"`
+	File      string       `json:"file"`
+	StartLine int          `json:"startLine"`
+	EndLine   int          `json:"endLine"`
+	Closures  []*LineRange `json:"closures,omitempty"`
+	Copy      bool         `json:"copy,omitempty"`
+	Pointer   bool         `json:"pointer,omitempty"`
+}
+
+// LineRange is an inclusive range of source lines.
+type LineRange struct {
+	StartLine int `json:"startLine"`
+	EndLine   int `json:"endLine"`
 }
 
 // FindConstructors locates all constructor functions for SomeObjects in the project.
+// It parses rootPath for this scan only, see FindConstructorsInWalk to share one parse across scans.
 //
 // Parameters:
 //   - rootPath: The root directory path to scan for Go files
@@ -189,54 +324,86 @@ type ConstructorInfo struct {
 //   - A map of constructor names to their location information
 //   - An error if the scan fails, nil otherwise
 func FindConstructors(rootPath string, typeDeclarations map[string]bool) (map[string]*ConstructorInfo, error) {
-	constructors := make(map[string]*ConstructorInfo)
+	result, err := FindConstructorsInWalk(NewOptions().Walker(rootPath), typeDeclarations)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
 
-	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil || filepath.Ext(path) != ".go" {
-			return nil
-		}
+	return result, nil
+}
 
-		// Skip test files - we intentionally allow zero-value initializations in tests
-		// to provide flexibility for testing scenarios that don't require full domain validation
-		if strings.HasSuffix(path, "_test.go") {
-			return nil
-		}
+// FindConstructorsInWalk locates all constructor functions for SomeObjects in the project.
+// It walks the files of walk, so the scan can share one parse with other scans, see Options.Walker.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - voTypes: A map of SomeObjects type names to search constructors for
+//
+// Returns:
+//   - A map of constructor names to their location information
+//   - An error if the scan fails, nil otherwise
+func FindConstructorsInWalk(walk Walker, typeDeclarations map[string]bool) (map[string]*ConstructorInfo, error) {
+	constructors, err := FindConstructorsWithFactories(walk, typeDeclarations, false)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
 
-		fileSet := token.NewFileSet()
-		file, err := parser.ParseFile(fileSet, path, nil, parser.ParseComments)
-		if err != nil {
-			return nil
-		}
+	return constructors, nil
+}
 
+// FindConstructorsWithFactories locates all constructor functions for SomeObjects in the project,
+// optionally linking factories whose result is not a SomeObject, such as an interface, to the types they return.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - typeDeclarations: A map of SomeObjects type names to search constructors for
+//   - factories: Whether interface-returning factories are linked to their returned types, see FactoryTypeKeys
+//
+// Returns:
+//   - A map of constructor names to their location information
+//   - An error if the scan fails, nil otherwise
+func FindConstructorsWithFactories(walk Walker, typeDeclarations map[string]bool, factories bool) (map[string]*ConstructorInfo, error) {
+	constructors := make(map[string]*ConstructorInfo)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
 		currentPackage := file.Name.Name
 
 		ast.Inspect(file, func(n ast.Node) bool {
 			funcDecl, ok := n.(*ast.FuncDecl)
-			if !ok || funcDecl.Name == nil || !strings.HasPrefix(funcDecl.Name.Name, "New") {
+			if !ok {
 				return true
 			}
 
-			if funcDecl.Type.Results != nil && len(funcDecl.Type.Results.List) > 0 {
-				if ident, ok := funcDecl.Type.Results.List[0].Type.(*ast.Ident); ok {
-					typeKey := currentPackage + "." + ident.Name
-					if typeDeclarations[typeKey] {
-						start := fileSet.Position(funcDecl.Pos()).Line
-						end := fileSet.Position(funcDecl.End()).Line
-
-						key := path + ":" + funcDecl.Name.Name + ":" + typeKey
-						constructors[key] = &ConstructorInfo{
-							File:      path,
-							StartLine: start,
-							EndLine:   end,
-						}
-					}
+			// Copy and transform constructors build a new value from an existing one
+			if typeKey, ok := CopyConstructedTypeKey(currentPackage, funcDecl); ok && typeDeclarations[typeKey] {
+				key := path + ":" + funcDecl.Name.Name + ":" + typeKey
+				constructors[key] = newConstructorInfo(path, fileSet, currentPackage, typeKey, funcDecl)
+				constructors[key].Copy = true
+
+				return true
+			}
+
+			typeKey, ok := ConstructedTypeKey(currentPackage, funcDecl)
+			if !ok {
+				return true
+			}
+
+			typeKeys := []string{typeKey}
+			if !typeDeclarations[typeKey] {
+				if !factories {
+					return true
 				}
+
+				typeKeys = FactoryTypeKeys(file, currentPackage, funcDecl, typeDeclarations)
+			}
+
+			for _, typeKey := range typeKeys {
+				key := path + ":" + funcDecl.Name.Name + ":" + typeKey
+				constructors[key] = newConstructorInfo(path, fileSet, currentPackage, typeKey, funcDecl)
 			}
 
 			return true
 		})
-
-		return nil
 	})
 
 	if err != nil {
@@ -246,6 +413,122 @@ func FindConstructors(rootPath string, typeDeclarations map[string]bool) (map[st
 	return constructors, nil
 }
 
+// newConstructorInfo describes a constructor of typeKey.
+func newConstructorInfo(path string, fileSet *token.FileSet, currentPackage string, typeKey string, funcDecl *ast.FuncDecl) *ConstructorInfo {
+	var closures []*LineRange
+
+	ast.Inspect(funcDecl.Body, func(inner ast.Node) bool {
+		if funcLit, ok := inner.(*ast.FuncLit); ok {
+			closures = append(closures, &LineRange{
+				StartLine: fileSet.Position(funcLit.Pos()).Line,
+				EndLine:   fileSet.Position(funcLit.End()).Line,
+			})
+		}
+
+		return true
+	})
+
+	return &ConstructorInfo{
+		Name:      funcDecl.Name.Name,
+		Package:   currentPackage,
+		TypeName:  typeKey,
+		File:      path,
+		StartLine: fileSet.Position(funcDecl.Pos()).Line,
+		EndLine:   fileSet.Position(funcDecl.End()).Line,
+		Closures:  closures,
+		Pointer:   returnsPointer(funcDecl),
+	}
+}
+
+// ConstructedTypeKey returns the type a constructor-like function builds.
+// A constructor is a function whose name starts with "New" and whose first result is a named type
+// or a pointer to one, such as `func NewOrder(id string) *Order`.
+//
+// Parameters:
+//   - currentPackage: The package name of the file declaring the function
+//   - funcDecl: The function declaration to check
+//
+// Returns:
+//   - The "package.TypeName" key and true for constructor-like functions, empty string and false otherwise
+func ConstructedTypeKey(currentPackage string, funcDecl *ast.FuncDecl) (string, bool) {
+	if funcDecl.Name == nil || !strings.HasPrefix(funcDecl.Name.Name, "New") {
+		return "", false
+	}
+
+	return resultTypeKey(currentPackage, funcDecl)
+}
+
+// CopyConstructedTypeKey returns the type a copy or transform constructor builds from an existing value,
+// such as `func NewLocationFrom(other Location) Location` or `func (l Location) Moved(dx int) Location`.
+// It is a function or method, with or without the "New" prefix, whose first result is a named type
+// that its receiver or one of its parameters also has, by value or as a pointer.
+//
+// Parameters:
+//   - currentPackage: The package name of the file declaring the function
+//   - funcDecl: The function declaration to check
+//
+// Returns:
+//   - The "package.TypeName" key and true for copy constructors, empty string and false otherwise
+func CopyConstructedTypeKey(currentPackage string, funcDecl *ast.FuncDecl) (string, bool) {
+	if funcDecl.Name == nil {
+		return "", false
+	}
+
+	typeKey, ok := resultTypeKey(currentPackage, funcDecl)
+	if !ok {
+		return "", false
+	}
+
+	if receiverKey, _, ok := ReceiverTypeKey(currentPackage, funcDecl); ok && receiverKey == typeKey {
+		return typeKey, true
+	}
+
+	for _, param := range funcDecl.Type.Params.List {
+		paramType := ast.Unparen(param.Type)
+
+		if star, ok := paramType.(*ast.StarExpr); ok {
+			paramType = ast.Unparen(star.X)
+		}
+
+		if ident, ok := paramType.(*ast.Ident); ok && currentPackage+"."+ident.Name == typeKey {
+			return typeKey, true
+		}
+	}
+
+	return "", false
+}
+
+// resultTypeKey returns the "package.TypeName" key of a function's first result if it is a named type of the package
+// or a pointer to one.
+func resultTypeKey(currentPackage string, funcDecl *ast.FuncDecl) (string, bool) {
+	if funcDecl.Type.Results == nil || len(funcDecl.Type.Results.List) == 0 {
+		return "", false
+	}
+
+	resultType := ast.Unparen(funcDecl.Type.Results.List[0].Type)
+	if star, ok := resultType.(*ast.StarExpr); ok {
+		resultType = ast.Unparen(star.X)
+	}
+
+	ident, ok := resultType.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+
+	return currentPackage + "." + ident.Name, true
+}
+
+// returnsPointer reports whether a function's first result is a pointer.
+func returnsPointer(funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Type.Results == nil || len(funcDecl.Type.Results.List) == 0 {
+		return false
+	}
+
+	_, ok := ast.Unparen(funcDecl.Type.Results.List[0].Type).(*ast.StarExpr)
+
+	return ok
+}
+
 // IsInsideConstructor checks if a given line number is within a constructor function.
 //
 // Parameters:
@@ -268,7 +551,46 @@ func IsInsideConstructor(file string, line int, typeDeclaration string, construc
 	return false
 }
 
+// IsInsideConstructorBody checks if a given line number is directly within a constructor function,
+// excluding function literals nested in its body.
+//
+// Parameters:
+//   - file: The file path to check
+//   - line: The line number to check
+//   - typeDeclaration: The SomeObject type name (now in format "package.TypeName")
+//   - constructors: A map of constructor information
+//
+// Returns:
+//   - true if the line is inside a constructor for the specified SomeObject and outside its closures, false otherwise
+func IsInsideConstructorBody(file string, line int, typeDeclaration string, constructors map[string]*ConstructorInfo) bool {
+	for key, constructor := range constructors {
+		if !strings.HasSuffix(key, ":"+typeDeclaration) || constructor.File != file {
+			continue
+		}
+
+		if line < constructor.StartLine || line > constructor.EndLine {
+			continue
+		}
+
+		inClosure := false
+
+		for _, closure := range constructor.Closures {
+			if line >= closure.StartLine && line <= closure.EndLine {
+				inClosure = true
+				break
+			}
+		}
+
+		if !inClosure {
+			return true
+		}
+	}
+
+	return false
+}
+
 // FindZeroValueInitializations scans for zero-value initializations of SomeObjects outside constructors.
+// It parses rootPath for this scan only, see FindZeroValueInitializationsInWalk to share one parse across scans.
 //
 // Parameters:
 //   - rootPath: The root directory path to scan for Go files
@@ -276,35 +598,70 @@ func IsInsideConstructor(file string, line int, typeDeclaration string, construc
 //   - constructors: A map of constructor information for checking scope
 //
 // Returns:
-//   - A map of violation messages indicating zero-value initialization violations
+//   - A map of violation messages to zero-value initialization violations
 //   - An error if the scan fails, nil otherwise
-func FindZeroValueInitializations(rootPath string, markerName string, typeDeclarations map[string]bool, constructors map[string]*ConstructorInfo) (map[string]bool, error) {
-	violations := make(map[string]bool)
+func FindZeroValueInitializations(rootPath string, markerName string, typeDeclarations map[string]bool, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	result, err := FindZeroValueInitializationsInWalk(NewOptions().Walker(rootPath), markerName, typeDeclarations, constructors)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
 
-	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil || filepath.Ext(path) != ".go" {
-			return nil
-		}
+	return result, nil
+}
 
-		// Skip test files - we intentionally allow zero-value initializations in tests
-		// to provide flexibility for testing scenarios that don't require full domain validation
-		if strings.HasSuffix(path, "_test.go") {
-			return nil
-		}
+// FindZeroValueInitializationsInWalk scans for zero-value initializations of SomeObjects outside constructors.
+// It walks the files of walk, so the scan can share one parse with other scans, see Options.Walker.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - voTypes: A map of SomeObjects type names
+//   - constructors: A map of constructor information for checking scope
+//
+// Returns:
+//   - A map of violation messages to zero-value initialization violations
+//   - An error if the scan fails, nil otherwise
+func FindZeroValueInitializationsInWalk(walk Walker, markerName string, typeDeclarations map[string]bool, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	violations, err := FindZeroValueInitializationsWithScope(walk, markerName, typeDeclarations, constructors, false)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
 
-		fileSet := token.NewFileSet()
-		file, err := parser.ParseFile(fileSet, path, nil, parser.ParseComments)
-		if err != nil {
-			return nil
-		}
+	return violations, nil
+}
 
+// FindZeroValueInitializationsWithScope works like FindZeroValueInitializationsInWalk
+// with a choice of the constructor scope, see Options.StrictConstructorScope.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - voTypes: A map of SomeObjects type names
+//   - constructors: A map of constructor information for checking scope
+//   - strictScope: When true, function literals nested in constructors are out of scope
+//
+// Returns:
+//   - A map of violation messages to zero-value initialization violations
+//   - An error if the scan fails, nil otherwise
+func FindZeroValueInitializationsWithScope(walk Walker, markerName string, typeDeclarations map[string]bool, constructors map[string]*ConstructorInfo, strictScope bool) (map[string]*Violation, error) {
+	isInScope := IsInsideConstructor
+	if strictScope {
+		isInScope = IsInsideConstructorBody
+	}
+
+	violations := make(map[string]*Violation)
+
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
 		// Get current package name
 		currentPackage := file.Name.Name
 
 		ast.Inspect(file, func(n ast.Node) bool {
 			var compLit *ast.CompositeLit
-			var typeName string
-			var typePackage string = currentPackage // Default to current package
+			var typeExpr ast.Expr
+			var pos token.Pos
 
 			if cl, ok := n.(*ast.CompositeLit); ok {
 				// Case 1: Direct usage of Location{} (return value, argument, etc.)
@@ -325,68 +682,49 @@ func FindZeroValueInitializations(rootPath string, markerName string, typeDeclar
 						break
 					}
 				}
+			} else if callExpr, ok := n.(*ast.CallExpr); ok {
+				// Case 4: Conversion Location(struct{...}{}) of a wrapped zero value into the type
+				if len(callExpr.Args) == 1 && zeroValueLiteral(callExpr.Args[0]) != nil {
+					typeExpr = ast.Unparen(callExpr.Fun)
+					pos = callExpr.Pos()
+				}
 			}
 
-			// Skip if no CompositeLit found
-			if compLit == nil {
-				return true
-			}
+			if compLit != nil {
+				// Skip non zero-value initializations
+				if len(compLit.Elts) != 0 {
+					return true
+				}
 
-			// Skip non zero-value initializations
-			if len(compLit.Elts) != 0 {
-				return true
+				typeExpr = compLit.Type
+				pos = compLit.Pos()
 			}
 
-			// Determine type name and package
-			switch typ := compLit.Type.(type) {
-			case *ast.Ident:
-				typeName = typ.Name
-				// For Ident, type is in current package
-				typePackage = currentPackage
-			case *ast.SelectorExpr:
-				typeName = typ.Sel.Name
-				// For SelectorExpr, get the package from the selector
-				if ident, ok := typ.X.(*ast.Ident); ok {
-					typePackage = ident.Name
-					// Resolve imported package alias to full package name
-					for _, imp := range file.Imports {
-						importPath := strings.Trim(imp.Path.Value, `"`)
-						if imp.Name != nil && imp.Name.Name == typePackage {
-							// Use the last part of the import path as package name
-							parts := strings.Split(importPath, "/")
-							typePackage = parts[len(parts)-1]
-							break
-						} else if imp.Name == nil {
-							parts := strings.Split(importPath, "/")
-							if parts[len(parts)-1] == typePackage {
-								break
-							}
-						}
-					}
-				}
-			default:
+			// Skip if no candidate initialization found
+			if typeExpr == nil {
 				return true
 			}
 
 			// Create a unique key combining package and type name
-			typeKey := typePackage + "." + typeName
+			typeKey, ok := resolveTypeKey(file, currentPackage, packages, typeExpr)
+			if !ok {
+				return true
+			}
 
 			// Check if this is a Value Object type from the correct package
 			if !typeDeclarations[typeKey] {
 				return true
 			}
 
-			line := fileSet.Position(compLit.Pos()).Line
+			position := fileSet.Position(pos)
 
 			// Check if this is inside a constructor
-			if !IsInsideConstructor(path, line, typeKey, constructors) {
-				violation := fmt.Sprintf("VIOLATION: Direct zero-value initialization of %s %s at %s:%d", markerName, typeKey, path, line)
-				violations[violation] = true
+			if !isInScope(path, position.Line, typeKey, constructors) {
+				message := fmt.Sprintf("VIOLATION: Direct zero-value initialization of %s %s at %s:%d", markerName, typeKey, path, position.Line)
+				violations[message] = NewViolationAt(CheckZeroValueInitialization, typeKey, path, position.Line, position.Column, message)
 			}
 			return true
 		})
-
-		return nil
 	})
 
 	if err != nil {
@@ -395,3 +733,80 @@ func FindZeroValueInitializations(rootPath string, markerName string, typeDeclar
 
 	return violations, nil
 }
+
+// resolveTypeKey builds the "package.TypeName" key for a type expression.
+//
+// Parameters:
+//   - file: The AST file used to resolve imported package aliases
+//   - currentPackage: The package name of the file
+//   - packages: The index resolving import paths to declared package names
+//   - typeExpr: The type expression, either an identifier or a package selector, possibly parenthesized
+//
+// Returns:
+//   - The type key and true if the expression names a type, empty string and false otherwise
+func resolveTypeKey(file *ast.File, currentPackage string, packages PackageIndex, typeExpr ast.Expr) (string, bool) {
+	switch typ := ast.Unparen(typeExpr).(type) {
+	case *ast.Ident:
+		// Types declared inside function bodies shadow package-level ones and are never discovered
+		if typ.Obj != nil && file.Scope != nil && file.Scope.Lookup(typ.Name) != typ.Obj {
+			return "", false
+		}
+
+		// For Ident, type is in current package
+		return currentPackage + "." + typ.Name, true
+	case *ast.SelectorExpr:
+		// For SelectorExpr, get the package from the selector
+		ident, ok := typ.X.(*ast.Ident)
+		if !ok {
+			return "", false
+		}
+
+		typePackage := ident.Name
+
+		// Resolve imported package alias to the declared package name
+		for _, imp := range file.Imports {
+			importPath := strings.Trim(imp.Path.Value, `"`)
+			packageName, _ := packages.PackageName(importPath)
+
+			localName := packageName
+			if imp.Name != nil {
+				localName = imp.Name.Name
+			}
+
+			if localName == ident.Name {
+				typePackage = packageName
+				break
+			}
+		}
+
+		return typePackage + "." + typ.Sel.Name, true
+	default:
+		return "", false
+	}
+}
+
+// zeroValueLiteral looks through parentheses and type assertions for a zero-value composite literal.
+//
+// Parameters:
+//   - expr: The expression to unwrap
+//
+// Returns:
+//   - The empty composite literal if found, nil otherwise
+func zeroValueLiteral(expr ast.Expr) *ast.CompositeLit {
+	for {
+		switch e := expr.(type) {
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.TypeAssertExpr:
+			expr = e.X
+		case *ast.CompositeLit:
+			if len(e.Elts) != 0 {
+				return nil
+			}
+
+			return e
+		default:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package helpers
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IsIgnoredPath reports whether a file matches any of the ignore patterns.
+//
+// Parameters:
+//   - rootPath: The scanned root, file is made relative to it when possible
+//   - file: The file path to check
+//   - patterns: Glob patterns in filepath.Match syntax
+//
+// Returns:
+//   - true if the file or one of its parent directories matches a pattern, false otherwise
+func IsIgnoredPath(rootPath string, file string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return false
+	}
+
+	relPath := file
+	if rootPath != "" {
+		if rel, err := filepath.Rel(rootPath, file); err == nil {
+			relPath = rel
+		}
+	}
+
+	relPath = filepath.ToSlash(relPath)
+
+	for _, pattern := range patterns {
+		if !strings.Contains(pattern, "/") {
+			if matched, _ := path.Match(pattern, path.Base(relPath)); matched {
+				return true
+			}
+		}
+
+		for current := relPath; current != "." && current != "/" && current != ""; current = path.Dir(current) {
+			if matched, _ := path.Match(pattern, current); matched {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,145 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// returnsError reports whether the last result of a function type is the predeclared error type.
+func returnsError(funcType *ast.FuncType) bool {
+	if funcType.Results == nil || len(funcType.Results.List) == 0 {
+		return false
+	}
+
+	ident, ok := funcType.Results.List[len(funcType.Results.List)-1].Type.(*ast.Ident)
+
+	return ok && ident.Name == "error"
+}
+
+// FindIgnoredErrors scans constructors for errors discarded with the blank identifier,
+// e.g. `_ = validate(amount)` or `code, _ := parseCode(s)`, which let invalid input through.
+// Functions and methods of the project are recognised by their declared results, methods by name only.
+// A single call assigned to `_` whose callee is not declared in the project is assumed to return an error,
+// the usual reason to discard a result explicitly.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to ignored error violations
+//   - An error if the scan fails, nil otherwise
+func FindIgnoredErrors(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	// "package.Function" or method name -> whether its last result is an error
+	functions := make(map[string]bool)
+	methods := make(map[string]bool)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+
+			if funcDecl.Recv == nil {
+				functions[file.Name.Name+"."+funcDecl.Name.Name] = returnsError(funcDecl.Type)
+				continue
+			}
+
+			// A method name declared both with and without an error result stays ambiguous and is not flagged
+			if returns, ok := methods[funcDecl.Name.Name]; !ok || returns {
+				methods[funcDecl.Name.Name] = returnsError(funcDecl.Type)
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	violations := make(map[string]*Violation)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		// callReturnsError resolves whether a call returns an error, and whether its callee is known at all
+		callReturnsError := func(call *ast.CallExpr) (bool, bool) {
+			// Builtins and conversions to predeclared types, e.g. `_ = append(s, x)`, never return an error
+			if ident, ok := ast.Unparen(call.Fun).(*ast.Ident); ok && ident.Obj == nil && types.Universe.Lookup(ident.Name) != nil {
+				return false, true
+			}
+
+			if function, ok := resolveTypeKey(file, currentPackage, packages, call.Fun); ok {
+				returns, known := functions[function]
+
+				return returns, known
+			}
+
+			if selector, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr); ok {
+				returns, known := methods[selector.Sel.Name]
+
+				return returns, known
+			}
+
+			return false, false
+		}
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			typeKey, ok := ConstructedTypeKey(currentPackage, funcDecl)
+			if !ok || !typeDeclarations[typeKey] {
+				continue
+			}
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				assign, ok := n.(*ast.AssignStmt)
+				if !ok || len(assign.Rhs) != 1 {
+					return true
+				}
+
+				call, ok := ast.Unparen(assign.Rhs[0]).(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				blank, ok := assign.Lhs[len(assign.Lhs)-1].(*ast.Ident)
+				if !ok || blank.Name != "_" {
+					return true
+				}
+
+				returns, known := callReturnsError(call)
+				if !returns && (known || len(assign.Lhs) > 1) {
+					return true
+				}
+
+				position := fileSet.Position(assign.Pos())
+
+				message := fmt.Sprintf("VIOLATION: Constructor %s of %s %s ignores the error of %s at %s:%d (%s)", funcDecl.Name.Name, markerName, typeKey, types.ExprString(call.Fun), path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,146 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindIncompleteConstructions scans constructors for keyed `return T{...}` or `return &T{...}` literals
+// that leave some of the type's fields unset.
+// Empty literals, usually returned together with an error, and positional
+// literals, which always set every field, are not reported.
+// It parses rootPath for this scan only, see FindIncompleteConstructionsInWalk to share one parse across scans.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeFields: A map of SomeObjects type names to their field names
+//
+// Returns:
+//   - A map of violation messages to incomplete construction violations
+//   - An error if the scan fails, nil otherwise
+func FindIncompleteConstructions(rootPath string, checkName string, markerName string, typeFields map[string][]string) (map[string]*Violation, error) {
+	result, err := FindIncompleteConstructionsInWalk(NewOptions().Walker(rootPath), checkName, markerName, typeFields)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return result, nil
+}
+
+// FindIncompleteConstructionsInWalk scans constructors for keyed `return T{...}` literals
+// that leave some of the type's fields unset.
+// Empty literals, usually returned together with an error, and positional
+// literals, which always set every field, are not reported.
+// It walks the files of walk, so the scan can share one parse with other scans, see Options.Walker.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeFields: A map of SomeObjects type names to their field names
+//
+// Returns:
+//   - A map of violation messages to incomplete construction violations
+//   - An error if the scan fails, nil otherwise
+func FindIncompleteConstructionsInWalk(walk Walker, checkName string, markerName string, typeFields map[string][]string) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			typeKey, ok := ConstructedTypeKey(currentPackage, funcDecl)
+			if !ok {
+				continue
+			}
+
+			fields, ok := typeFields[typeKey]
+			if !ok {
+				continue
+			}
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				returnStmt, ok := n.(*ast.ReturnStmt)
+				if !ok || len(returnStmt.Results) == 0 {
+					return true
+				}
+
+				result := ast.Unparen(returnStmt.Results[0])
+				if unary, ok := result.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+					result = ast.Unparen(unary.X)
+				}
+
+				compLit, ok := result.(*ast.CompositeLit)
+				if !ok || len(compLit.Elts) == 0 {
+					return true
+				}
+
+				litTypeKey, ok := resolveTypeKey(file, currentPackage, packages, compLit.Type)
+				if !ok || litTypeKey != typeKey {
+					return true
+				}
+
+				missing := missingFields(compLit, fields)
+				if len(missing) == 0 {
+					return true
+				}
+
+				position := fileSet.Position(returnStmt.Pos())
+
+				message := fmt.Sprintf("VIOLATION: Incomplete construction of %s %s leaves %s unset at %s:%d (%s)", markerName, typeKey, strings.Join(missing, ", "), path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
+
+// missingFields returns the fields not set by a keyed composite literal.
+func missingFields(compLit *ast.CompositeLit, fields []string) []string {
+	set := make(map[string]bool)
+
+	for _, elt := range compLit.Elts {
+		keyValue, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			// Positional literals must set every field
+			return nil
+		}
+
+		if ident, ok := keyValue.Key.(*ast.Ident); ok {
+			set[ident.Name] = true
+		}
+	}
+
+	var missing []string
+
+	for _, field := range fields {
+		if !set[field] {
+			missing = append(missing, field)
+		}
+	}
+
+	return missing
+}
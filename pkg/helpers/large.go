@@ -0,0 +1,33 @@
+package helpers
+
+import (
+	"fmt"
+)
+
+// FindLargeTypes reports SomeObject types declaring more data fields than allowed,
+// which often points at a missing entity or aggregate the fields belong to.
+//
+// Parameters:
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - maxFields: The largest allowed number of data fields
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//   - typeFields: A map of type names to their field names, see FindTypeFields
+//
+// Returns:
+//   - A map of violation messages to large type violations
+func FindLargeTypes(checkName string, markerName string, maxFields int, locations map[string]*TypeLocation, typeFields map[string][]string) map[string]*Violation {
+	violations := make(map[string]*Violation)
+
+	for typeKey, fields := range typeFields {
+		location, ok := locations[typeKey]
+		if !ok || len(fields) <= maxFields {
+			continue
+		}
+
+		message := fmt.Sprintf("VIOLATION: %s %s has %d fields, more than %d, at %s:%d (%s)", markerName, typeKey, len(fields), maxFields, location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations
+}
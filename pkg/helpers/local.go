@@ -0,0 +1,89 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// isFunctionNode reports whether a node is a function declaration or literal,
+// whose body may hold local type declarations.
+func isFunctionNode(n ast.Node) bool {
+	switch n.(type) {
+	case *ast.FuncDecl, *ast.FuncLit:
+		return true
+	default:
+		return false
+	}
+}
+
+// FindLocalTypeDeclarations scans for SomeObject structs declared inside function bodies,
+// such as `func f() { type X struct { _ valueobject.ValueObject } }`.
+// Local types are invisible outside the function, so they are never discovered as SomeObjects.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - isTypeDeclaration: The predicate recognising SomeObject structs
+//
+// Returns:
+//   - A map of violation messages to local declaration violations
+//   - An error if the scan fails, nil otherwise
+func FindLocalTypeDeclarations(walk Walker, checkName string, markerName string, isTypeDeclaration IsTypeDeclaration) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			var (
+				body     *ast.BlockStmt
+				function string
+			)
+
+			switch fn := n.(type) {
+			case *ast.FuncDecl:
+				body, function = fn.Body, "function "+fn.Name.Name
+			case *ast.FuncLit:
+				body, function = fn.Body, "a function literal"
+			default:
+				return true
+			}
+
+			if body == nil {
+				return false
+			}
+
+			ast.Inspect(body, func(n ast.Node) bool {
+				typeSpec, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+
+				structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+				if !ok || !isTypeDeclaration(file, structType) {
+					return true
+				}
+
+				typeKey := currentPackage + "." + typeSpec.Name.Name
+				position := fileSet.Position(typeSpec.Pos())
+
+				message := fmt.Sprintf("VIOLATION: %s %s is declared inside %s at %s:%d (%s)", markerName, typeKey, function, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+
+				return true
+			})
+
+			return false
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
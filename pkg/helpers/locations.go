@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// TypeLocation contains location information about a SomeObject type declaration.
+type TypeLocation struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// FindTypeLocations locates the declarations of the given SomeObject types.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of type names to their declaration location
+//   - An error if the scan fails, nil otherwise
+func FindTypeLocations(walk Walker, typeDeclarations map[string]bool) (map[string]*TypeLocation, error) {
+	locations := make(map[string]*TypeLocation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			if isFunctionNode(n) {
+				return false
+			}
+
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			typeKey := currentPackage + "." + typeSpec.Name.Name
+			if typeDeclarations[typeKey] {
+				position := fileSet.Position(typeSpec.Pos())
+
+				locations[typeKey] = &TypeLocation{
+					File:   path,
+					Line:   position.Line,
+					Column: position.Column,
+				}
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return locations, nil
+}
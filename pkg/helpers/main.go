@@ -0,0 +1,31 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FindStereotypesInMain reports SomeObject types declared in package main,
+// where domain types never belong.
+//
+// Parameters:
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//
+// Returns:
+//   - A map of violation messages to stereotype-in-main violations
+func FindStereotypesInMain(checkName string, markerName string, locations map[string]*TypeLocation) map[string]*Violation {
+	violations := make(map[string]*Violation)
+
+	for typeKey, location := range locations {
+		if !strings.HasPrefix(typeKey, "main.") {
+			continue
+		}
+
+		message := fmt.Sprintf("VIOLATION: %s %s is declared in package main at %s:%d (%s)", markerName, typeKey, location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations
+}
@@ -0,0 +1,84 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindMapKeyUsages scans for map types keyed by SomeObject values.
+// Map types are found wherever they appear: type specs, composite literals,
+// variable declarations and function signatures. Pointer keys are not reported.
+// It parses rootPath for this scan only, see FindMapKeyUsagesInWalk to share one parse across scans.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to map key violations
+//   - An error if the scan fails, nil otherwise
+func FindMapKeyUsages(rootPath string, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	result, err := FindMapKeyUsagesInWalk(NewOptions().Walker(rootPath), checkName, markerName, typeDeclarations)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return result, nil
+}
+
+// FindMapKeyUsagesInWalk scans for map types keyed by SomeObject values.
+// Map types are found wherever they appear: type specs, composite literals,
+// variable declarations and function signatures. Pointer keys are not reported.
+// It walks the files of walk, so the scan can share one parse with other scans, see Options.Walker.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to map key violations
+//   - An error if the scan fails, nil otherwise
+func FindMapKeyUsagesInWalk(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			mapType, ok := n.(*ast.MapType)
+			if !ok {
+				return true
+			}
+
+			typeKey, ok := resolveTypeKey(file, currentPackage, packages, ast.Unparen(mapType.Key))
+			if !ok || !typeDeclarations[typeKey] {
+				return true
+			}
+
+			position := fileSet.Position(mapType.Pos())
+
+			message := fmt.Sprintf("VIOLATION: %s %s used as map key at %s:%d (%s)", markerName, typeKey, path, position.Line, checkName)
+			violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,87 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindUnusedMarkerImports reports files importing a marker package without declaring any struct marked with it,
+// a leftover import or a type that was meant to become a SomeObject but lacks the "_" marker field.
+// Files using the package for anything besides its marker types, such as calling its validators, are skipped.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - fullPackage: The full package path of the marker
+//   - declaredNames: The marker type names declared by the package, e.g. "Aggregate" and "AggregateRoot"
+//   - isTypeDeclaration: The predicate recognising structs marked with any of the markers
+//
+// Returns:
+//   - A map of violation messages to unused marker import violations
+//   - An error if the scan fails, nil otherwise
+func FindUnusedMarkerImports(walk Walker, checkName string, markerName string, fullPackage string, declaredNames []string, isTypeDeclaration IsTypeDeclaration) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		var importSpec *ast.ImportSpec
+
+		for _, imp := range file.Imports {
+			if strings.Trim(imp.Path.Value, `"`) != fullPackage {
+				continue
+			}
+
+			// Blank and dot imports do not refer to the marker through an alias
+			if imp.Name != nil && (imp.Name.Name == "_" || imp.Name.Name == ".") {
+				continue
+			}
+
+			importSpec = imp
+
+			break
+		}
+
+		if importSpec == nil {
+			return
+		}
+
+		aliases := GetPackageAliases(file, fullPackage)
+		marked := false
+		otherUse := false
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.StructType:
+				if isTypeDeclaration(file, node) {
+					marked = true
+				}
+			case *ast.SelectorExpr:
+				ident, ok := node.X.(*ast.Ident)
+				if ok && ident.Obj == nil && isOneOf(ident.Name, aliases) && !isOneOf(node.Sel.Name, declaredNames) {
+					otherUse = true
+				}
+			}
+
+			return !marked && !otherUse
+		})
+
+		if marked || otherUse {
+			return
+		}
+
+		position := fileSet.Position(importSpec.Pos())
+
+		message := fmt.Sprintf("VIOLATION: %s marker package is imported without any type marked as %s at %s:%d (%s)", markerName, markerName, path, position.Line, checkName)
+		violations[message] = NewViolationAt(checkName, "", path, position.Line, position.Column, message)
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,112 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// isMarkerType reports whether a type expression, possibly a pointer, names the SomeObject marker.
+func isMarkerType(expr ast.Expr, pkgAliases []string, declaredName string) bool {
+	expr = ast.Unparen(expr)
+
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = ast.Unparen(star.X)
+	}
+
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != declaredName {
+		return false
+	}
+
+	ident, ok := selector.X.(*ast.Ident)
+
+	// Imported package names are never resolved by the parser, a resolved ident is a shadowing local
+	return ok && ident.Obj == nil && isOneOf(ident.Name, pkgAliases)
+}
+
+// FindMarkerMisuses reports uses of the SomeObject marker type that mark nothing: types defined as
+// or aliasing the marker, interfaces embedding it and struct fields holding it under another name than
+// the marker field, e.g. an embedded `valueobject.ValueObject`. Discovery only recognises the marker field
+// of a struct, so such types silently escape validation.
+// A marker field inside an interface, `_ valueobject.ValueObject`, is a syntax error and its file is never parsed.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - fullPackage: The full package path of the marker
+//   - markerField: The name of the marker field, usually "_"
+//   - declaredName: The marker type name, also used in violation messages
+//
+// Returns:
+//   - A map of violation messages to marker misuse violations
+//   - An error if the scan fails, nil otherwise
+func FindMarkerMisuses(walk Walker, checkName string, fullPackage string, markerField string, declaredName string) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		pkgAliases := GetPackageAliases(file, fullPackage)
+		if len(pkgAliases) == 0 {
+			return
+		}
+
+		currentPackage := file.Name.Name
+
+		report := func(typeSpec *ast.TypeSpec, node ast.Node, misuse string) {
+			typeKey := currentPackage + "." + typeSpec.Name.Name
+			position := fileSet.Position(node.Pos())
+
+			message := fmt.Sprintf("VIOLATION: %s marker is %s in %s at %s:%d (%s)", declaredName, misuse, typeKey, path, position.Line, checkName)
+			violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			if isFunctionNode(n) {
+				return false
+			}
+
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			if isMarkerType(typeSpec.Type, pkgAliases, declaredName) {
+				report(typeSpec, typeSpec, "used as the underlying type instead of a struct field")
+
+				return true
+			}
+
+			switch typ := ast.Unparen(typeSpec.Type).(type) {
+			case *ast.InterfaceType:
+				for _, method := range typ.Methods.List {
+					if len(method.Names) == 0 && isMarkerType(method.Type, pkgAliases, declaredName) {
+						report(typeSpec, method, "embedded in an interface")
+					}
+				}
+			case *ast.StructType:
+				for _, field := range typ.Fields.List {
+					if !isMarkerType(field.Type, pkgAliases, declaredName) {
+						continue
+					}
+
+					switch {
+					case len(field.Names) == 0:
+						report(typeSpec, field, fmt.Sprintf("embedded instead of declared as the %q field", markerField))
+					case len(field.Names) != 1 || field.Names[0].Name != markerField:
+						report(typeSpec, field, fmt.Sprintf("held by field %s instead of the %q field", field.Names[0].Name, markerField))
+					}
+				}
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindMisplacedMarkers scans for structs whose SomeObject marker field is not their last field,
+// breaking the convention of declaring the marker after the data fields, which often hints
+// at a hand-edited or merged struct.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - fullPackage: The full package path of the marker
+//   - markerField: The name of the marker field, usually "_"
+//   - declaredName: The marker type name, also used in violation messages
+//
+// Returns:
+//   - A map of violation messages to misplaced marker violations
+//   - An error if the scan fails, nil otherwise
+func FindMisplacedMarkers(walk Walker, checkName string, fullPackage string, markerField string, declaredName string) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		pkgAliases := GetPackageAliases(file, fullPackage)
+		if len(pkgAliases) == 0 {
+			return
+		}
+
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok || structType.Fields == nil {
+				return true
+			}
+
+			fields := structType.Fields.List
+
+			for i, field := range fields[:max(len(fields)-1, 0)] {
+				if isMarker, _ := IsSomeObjectMarkerField(field, pkgAliases, markerField, declaredName); !isMarker {
+					continue
+				}
+
+				following := 0
+				for _, next := range fields[i+1:] {
+					following += len(fieldDisplayNames(next))
+				}
+
+				typeKey := currentPackage + "." + typeSpec.Name.Name
+				position := fileSet.Position(field.Pos())
+
+				message := fmt.Sprintf("VIOLATION: %s marker of %s is followed by %d more fields at %s:%d (%s)", declaredName, typeKey, following, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
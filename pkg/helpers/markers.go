@@ -0,0 +1,33 @@
+package helpers
+
+import "go/ast"
+
+// Marker identifies a stereotype marker type, e.g. a previous location of a marker during a migration.
+//
+// Fields:
+//   - FullPackage: The full import path of the package declaring the marker type
+//   - DeclaredName: The marker type name, the stereotype's own marker name when empty
+type Marker struct {
+	FullPackage  string `yaml:"package" json:"package"`
+	DeclaredName string `yaml:"name" json:"name"`
+}
+
+// IsAnySomeObjectTypeDeclaration checks if a struct type is marked with any of the given markers, see IsSomeObjectTypeDeclaration.
+//
+// Parameters:
+//   - file: The AST file to check imports from
+//   - structType: The AST struct type to check
+//   - markers: The accepted markers
+//   - markerField: The name of the marker field, usually "_"
+//
+// Returns:
+//   - true if the struct contains one of the markers named markerField, false otherwise
+func IsAnySomeObjectTypeDeclaration(file *ast.File, structType *ast.StructType, markers []Marker, markerField string) bool {
+	for _, marker := range markers {
+		if IsSomeObjectTypeDeclaration(file, structType, marker.FullPackage, markerField, marker.DeclaredName) {
+			return true
+		}
+	}
+
+	return false
+}
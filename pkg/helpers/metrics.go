@@ -0,0 +1,86 @@
+package helpers
+
+import (
+	"time"
+)
+
+// Phase identifies a timed part of a validation run.
+type Phase int
+
+const (
+	PhaseParse Phase = iota
+	PhaseWalk
+	PhaseTypeDiscovery
+	PhaseConstructorDiscovery
+	PhaseViolationDetection
+)
+
+// Metrics records where the time of a validation run goes.
+// Files are parsed on the first walk and walks happen inside the discovery and detection phases,
+// so Parse and Walk overlap the phases that trigger them.
+//
+// Fields:
+//   - Files: The number of parsed Go files
+//   - Parse: Time spent reading the directory tree and parsing files
+//   - Walk: Time spent visiting the parsed files
+//   - TypeDiscovery: Time spent discovering stereotype types
+//   - ConstructorDiscovery: Time spent discovering constructors
+//   - ViolationDetection: Time spent running the checks
+type Metrics struct {
+	Files                int           `json:"files"`
+	Parse                time.Duration `json:"parse"`
+	Walk                 time.Duration `json:"walk"`
+	TypeDiscovery        time.Duration `json:"typeDiscovery"`
+	ConstructorDiscovery time.Duration `json:"constructorDiscovery"`
+	ViolationDetection   time.Duration `json:"violationDetection"`
+}
+
+// Track starts timing a phase, the returned function stops it and adds the elapsed time.
+// It is safe to call on nil Metrics, which records nothing.
+//
+// Parameters:
+//   - phase: The phase to time
+//
+// Returns:
+//   - The function stopping the timer
+func (m *Metrics) Track(phase Phase) func() {
+	if m == nil {
+		return func() {}
+	}
+
+	start := time.Now()
+
+	return func() {
+		elapsed := time.Since(start)
+
+		switch phase {
+		case PhaseParse:
+			m.Parse += elapsed
+		case PhaseWalk:
+			m.Walk += elapsed
+		case PhaseTypeDiscovery:
+			m.TypeDiscovery += elapsed
+		case PhaseConstructorDiscovery:
+			m.ConstructorDiscovery += elapsed
+		case PhaseViolationDetection:
+			m.ViolationDetection += elapsed
+		}
+	}
+}
+
+// Add accumulates the counts and durations of other.
+//
+// Parameters:
+//   - other: The metrics to add, may be nil
+func (m *Metrics) Add(other *Metrics) {
+	if m == nil || other == nil {
+		return
+	}
+
+	m.Files += other.Files
+	m.Parse += other.Parse
+	m.Walk += other.Walk
+	m.TypeDiscovery += other.TypeDiscovery
+	m.ConstructorDiscovery += other.ConstructorDiscovery
+	m.ViolationDetection += other.ViolationDetection
+}
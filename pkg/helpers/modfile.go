@@ -0,0 +1,89 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// ReadModulePath returns the module path declared by the go.mod file of root, or of its nearest parent
+// directory with one, so a root such as "./internal/domain" resolves to the module it belongs to.
+// Only the module directive is read, require and replace directives are skipped: a replacement changes
+// where a dependency comes from, never the path of the module itself.
+//
+// Parameters:
+//   - root: The directory to start the search from
+//
+// Returns:
+//   - The module path, e.g. "github.com/acme/shop"
+//   - An error wrapping ErrModulePathNotFound if no go.mod file declares a module, nil otherwise
+func ReadModulePath(root string) (string, error) {
+	_, modulePath, err := findModule(root)
+	if err != nil {
+		return "", ge.Pin(err)
+	}
+
+	return modulePath, nil
+}
+
+// findModule returns the directory of the nearest go.mod file at or above dir and the module path it declares.
+func findModule(dir string) (string, string, error) {
+	current, err := filepath.Abs(dir)
+	if err != nil {
+		return "", "", ge.Pin(err, ge.Params{"dir": dir})
+	}
+
+	for {
+		goMod := filepath.Join(current, "go.mod")
+
+		data, err := os.ReadFile(goMod)
+		if err == nil {
+			modulePath := parseModulePath(data)
+			if modulePath == "" {
+				return "", "", ge.Pin(fmt.Errorf("%w: no module directive in %s", ErrModulePathNotFound, goMod))
+			}
+
+			return current, modulePath, nil
+		}
+
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", "", ge.Pin(err, ge.Params{"file": goMod})
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			return "", "", ge.Pin(fmt.Errorf("%w: no go.mod file above %s", ErrModulePathNotFound, dir))
+		}
+
+		current = parent
+	}
+}
+
+// parseModulePath extracts the path of the module directive of a go.mod file,
+// e.g. "example.com/shop" from `module example.com/shop // shop`, empty if there is none.
+func parseModulePath(data []byte) string {
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != "module" {
+			continue
+		}
+
+		if unquoted, err := strconv.Unquote(fields[1]); err == nil {
+			return unquoted
+		}
+
+		return fields[1]
+	}
+
+	return ""
+}
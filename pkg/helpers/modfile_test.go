@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadModulePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		goMod   string
+		root    string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "root nested below go.mod",
+			goMod: "module example.com/shop\n\ngo 1.22\n",
+			root:  "internal/domain",
+			want:  "example.com/shop",
+		},
+		{
+			name:  "quoted module path",
+			goMod: "module \"example.com/shop\"\n",
+			want:  "example.com/shop",
+		},
+		{
+			name:  "trailing comment",
+			goMod: "module example.com/shop // the shop service\n",
+			want:  "example.com/shop",
+		},
+		{
+			name:  "replace directives",
+			goMod: "module example.com/shop\n\nrequire example.com/money v1.0.0\n\nreplace example.com/money => ../money\n\nreplace (\n\texample.com/tax => ./tax\n)\n",
+			want:  "example.com/shop",
+		},
+		{
+			name:    "no module directive",
+			goMod:   "go 1.22\n",
+			wantErr: ErrModulePathNotFound,
+		},
+		{
+			name:    "no go.mod",
+			wantErr: ErrModulePathNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			if tt.goMod != "" {
+				if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(tt.goMod), 0o644); err != nil {
+					t.Fatalf("os.WriteFile() error = %v", err)
+				}
+			}
+
+			root := filepath.Join(dir, tt.root)
+			if err := os.MkdirAll(root, 0o755); err != nil {
+				t.Fatalf("os.MkdirAll() error = %v", err)
+			}
+
+			got, err := ReadModulePath(root)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReadModulePath() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ReadModulePath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseModulePath(t *testing.T) {
+	tests := []struct {
+		name  string
+		goMod string
+		want  string
+	}{
+		{name: "plain", goMod: "module example.com/shop\n", want: "example.com/shop"},
+		{name: "quoted", goMod: "module \"example.com/shop\"\n", want: "example.com/shop"},
+		{name: "trailing comment", goMod: "module example.com/shop // shop\n", want: "example.com/shop"},
+		{name: "commented out", goMod: "// module example.com/old\nmodule example.com/shop\n", want: "example.com/shop"},
+		{name: "replace only", goMod: "replace example.com/shop => ../shop\n", want: ""},
+		{name: "empty", goMod: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseModulePath([]byte(tt.goMod)); got != tt.want {
+				t.Errorf("parseModulePath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// ReceiverTypeKey returns the type a method is declared on.
+//
+// Parameters:
+//   - currentPackage: The package name of the file declaring the method
+//   - funcDecl: The method declaration
+//
+// Returns:
+//   - The "package.TypeName" key of the receiver type
+//   - true if the receiver is a pointer, false otherwise
+//   - true if funcDecl is a method, false otherwise
+func ReceiverTypeKey(currentPackage string, funcDecl *ast.FuncDecl) (string, bool, bool) {
+	if funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 {
+		return "", false, false
+	}
+
+	recvType := ast.Unparen(funcDecl.Recv.List[0].Type)
+	isPointer := false
+
+	if star, ok := recvType.(*ast.StarExpr); ok {
+		recvType = ast.Unparen(star.X)
+		isPointer = true
+	}
+
+	// Generic receivers, e.g. func (s *Set[T]) Add()
+	switch typ := recvType.(type) {
+	case *ast.IndexExpr:
+		recvType = typ.X
+	case *ast.IndexListExpr:
+		recvType = typ.X
+	}
+
+	ident, ok := recvType.(*ast.Ident)
+	if !ok {
+		return "", false, false
+	}
+
+	return currentPackage + "." + ident.Name, isPointer, true
+}
+
+// MutatesReceiver reports whether a method body assigns to a field of its receiver.
+//
+// Parameters:
+//   - funcDecl: The method declaration
+//
+// Returns:
+//   - true if a receiver field is assigned, incremented or decremented, false otherwise
+func MutatesReceiver(funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Body == nil || funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 || len(funcDecl.Recv.List[0].Names) == 0 {
+		return false
+	}
+
+	receiver := funcDecl.Recv.List[0].Names[0].Name
+	mutates := false
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		switch stmt := n.(type) {
+		case *ast.AssignStmt:
+			if stmt.Tok == token.DEFINE {
+				return true
+			}
+
+			for _, lhs := range stmt.Lhs {
+				if isReceiverField(lhs, receiver) {
+					mutates = true
+				}
+			}
+		case *ast.IncDecStmt:
+			if isReceiverField(stmt.X, receiver) {
+				mutates = true
+			}
+		}
+
+		return !mutates
+	})
+
+	return mutates
+}
+
+// isReceiverField reports whether expr is a (possibly nested or indexed) field of the receiver.
+func isReceiverField(expr ast.Expr, receiver string) bool {
+	isField := false
+
+	for {
+		switch e := expr.(type) {
+		case *ast.SelectorExpr:
+			expr = e.X
+			isField = true
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.Ident:
+			return isField && e.Name == receiver
+		default:
+			return false
+		}
+	}
+}
+
+// FindExportedMutators scans for exported pointer-receiver methods of SomeObjects
+// that assign to the receiver's fields.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to exported mutator violations
+//   - An error if the scan fails, nil otherwise
+func FindExportedMutators(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || !funcDecl.Name.IsExported() {
+				continue
+			}
+
+			typeKey, isPointer, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			if !ok || !isPointer || !typeDeclarations[typeKey] {
+				continue
+			}
+
+			if !MutatesReceiver(funcDecl) {
+				continue
+			}
+
+			position := fileSet.Position(funcDecl.Pos())
+
+			message := fmt.Sprintf("VIOLATION: %s %s exposes mutating method %s at %s:%d (%s)", markerName, typeKey, funcDecl.Name.Name, path, position.Line, checkName)
+			violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
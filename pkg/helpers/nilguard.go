@@ -0,0 +1,122 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindUnguardedPointerDereferences scans methods of holder types for dereferences of their pointer fields,
+// such as `e.money.Amount()` or `*e.money`, not preceded by a nil comparison of the field in the same method.
+// It is a heuristic: guards in called functions, constructors guaranteeing the field and early returns
+// on other conditions are not understood, so every field is reported at most once per method.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - holderName: The marker name of the types holding the pointers, used in violation messages
+//   - targetName: The marker name of the pointed-to types, used in violation messages
+//   - pointerFields: A map of field names to pointer fields, see FindPointerFields
+//
+// Returns:
+//   - A map of violation messages to unguarded dereference violations
+//   - An error if the scan fails, nil otherwise
+func FindUnguardedPointerDereferences(walk Walker, checkName string, holderName string, targetName string, pointerFields map[string][]*PointerField) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	// holder -> field name -> pointer field
+	holderFields := make(map[string]map[string]*PointerField)
+
+	for _, fields := range pointerFields {
+		for _, field := range fields {
+			if holderFields[field.Holder] == nil {
+				holderFields[field.Holder] = make(map[string]*PointerField)
+			}
+
+			holderFields[field.Holder][field.Name] = field
+		}
+	}
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			holder, _, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			fields := holderFields[holder]
+
+			if !ok || fields == nil || len(funcDecl.Recv.List[0].Names) == 0 {
+				continue
+			}
+
+			receiver := funcDecl.Recv.List[0].Names[0].Name
+
+			// receiverField returns the pointer field an expression such as `e.money` reads
+			receiverField := func(expr ast.Expr) *PointerField {
+				selector, ok := ast.Unparen(expr).(*ast.SelectorExpr)
+				if !ok {
+					return nil
+				}
+
+				ident, ok := selector.X.(*ast.Ident)
+				if !ok || ident.Name != receiver {
+					return nil
+				}
+
+				return fields[selector.Sel.Name]
+			}
+
+			guarded := make(map[string]bool)
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				var field *PointerField
+
+				switch node := n.(type) {
+				case *ast.BinaryExpr:
+					if node.Op != token.EQL && node.Op != token.NEQ {
+						return true
+					}
+
+					for _, operands := range [][2]ast.Expr{{node.X, node.Y}, {node.Y, node.X}} {
+						if ident, ok := operands[1].(*ast.Ident); ok && ident.Name == "nil" {
+							if guard := receiverField(operands[0]); guard != nil {
+								guarded[guard.Name] = true
+							}
+						}
+					}
+
+					return true
+				case *ast.SelectorExpr:
+					field = receiverField(node.X)
+				case *ast.StarExpr:
+					field = receiverField(node.X)
+				}
+
+				if field == nil || guarded[field.Name] {
+					return true
+				}
+
+				// Report a field once per method
+				guarded[field.Name] = true
+				position := fileSet.Position(n.Pos())
+
+				message := fmt.Sprintf("VIOLATION: %s %s dereferences pointer field %s to %s %s in %s without a nil check at %s:%d (%s)", holderName, holder, field.Name, targetName, field.Target, funcDecl.Name.Name, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, holder, path, position.Line, position.Column, message)
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FindTypesWithoutConstructors reports SomeObject types that have no constructor at all,
+// so every initialization of them necessarily bypasses validation.
+//
+// Parameters:
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//   - constructors: A map of constructor information, see FindConstructors
+//
+// Returns:
+//   - A map of violation messages to missing constructor violations
+func FindTypesWithoutConstructors(checkName string, markerName string, locations map[string]*TypeLocation, constructors map[string]*ConstructorInfo) map[string]*Violation {
+	constructed := make(map[string]bool)
+
+	for key, constructor := range constructors {
+		if constructor.Copy {
+			continue
+		}
+
+		constructed[key[strings.LastIndex(key, ":")+1:]] = true
+	}
+
+	violations := make(map[string]*Violation)
+
+	for typeKey, location := range locations {
+		if constructed[typeKey] {
+			continue
+		}
+
+		message := fmt.Sprintf("VIOLATION: %s %s has no constructor at %s:%d (%s)", markerName, typeKey, location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations
+}
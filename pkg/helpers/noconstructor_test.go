@@ -0,0 +1,44 @@
+package helpers
+
+import (
+	"testing"
+)
+
+func TestFindTypesWithoutConstructorsAcceptsPointerConstructors(t *testing.T) {
+	walk := NewWalker("testdata/pointerconstructor")
+
+	types, err := FindTypeDeclarationsInWalk(walk, NewOptions().TypeDeclaration(valueObjectPackage, "_", "ValueObject"))
+	if err != nil {
+		t.Fatalf("FindTypeDeclarationsInWalk() error = %v", err)
+	}
+
+	constructors, err := FindConstructorsInWalk(walk, types)
+	if err != nil {
+		t.Fatalf("FindConstructorsInWalk() error = %v", err)
+	}
+
+	locations, err := FindTypeLocations(walk, types)
+	if err != nil {
+		t.Fatalf("FindTypeLocations() error = %v", err)
+	}
+
+	violations := FindTypesWithoutConstructors(CheckNoConstructor, "ValueObject", locations, constructors)
+	if len(violations) != 1 {
+		t.Fatalf("FindTypesWithoutConstructors() found %d violations, want 1: %v", len(violations), violations)
+	}
+
+	for _, violation := range violations {
+		if violation.TypeName != "orders.Discount" {
+			t.Errorf("violation type = %q, want %q", violation.TypeName, "orders.Discount")
+		}
+	}
+
+	// Pointer constructors give constructor scope but never make a type constructed by value
+	valueConstructed := ValueConstructedTypes(constructors)
+
+	for typeKey, want := range map[string]bool{"orders.Order": false, "orders.Money": true} {
+		if valueConstructed[typeKey] != want {
+			t.Errorf("ValueConstructedTypes()[%q] = %v, want %v", typeKey, valueConstructed[typeKey], want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// nondeterministicSource describes an imported package whose calls make a value non-deterministic.
+type nondeterministicSource struct {
+	Path string
+	Name string
+	// Funcs restricts the flagged functions, nil flags every function of the package.
+	Funcs map[string]bool
+}
+
+// nondeterministicSources lists the packages and functions that read the clock or a random source.
+var nondeterministicSources = []nondeterministicSource{
+	{Path: "time", Name: "time", Funcs: map[string]bool{"Now": true, "Since": true, "Until": true}},
+	{Path: "math/rand", Name: "rand"},
+	{Path: "math/rand/v2", Name: "rand"},
+	{Path: "crypto/rand", Name: "rand"},
+}
+
+// nondeterministicAliases maps the local names of the imported non-deterministic packages to their sources.
+func nondeterministicAliases(file *ast.File) map[string]nondeterministicSource {
+	aliases := make(map[string]nondeterministicSource)
+
+	for _, imp := range file.Imports {
+		importPath := strings.Trim(imp.Path.Value, `"`)
+
+		for _, source := range nondeterministicSources {
+			if source.Path != importPath {
+				continue
+			}
+
+			name := source.Name
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+
+			if name != "_" && name != "." {
+				aliases[name] = source
+			}
+		}
+	}
+
+	return aliases
+}
+
+// FindNondeterministicCalls scans constructors and methods of SomeObjects for calls
+// that read the clock or a random source, e.g. time.Now() or rand.Int().
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to non-deterministic call violations
+//   - An error if the scan fails, nil otherwise
+func FindNondeterministicCalls(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		aliases := nondeterministicAliases(file)
+		if len(aliases) == 0 {
+			return
+		}
+
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			typeKey, _, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			if !ok {
+				typeKey, ok = ConstructedTypeKey(currentPackage, funcDecl)
+			}
+
+			if !ok || !typeDeclarations[typeKey] {
+				continue
+			}
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				callExpr, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				selector, ok := callExpr.Fun.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+
+				ident, ok := selector.X.(*ast.Ident)
+				if !ok {
+					return true
+				}
+
+				source, ok := aliases[ident.Name]
+				if !ok || (source.Funcs != nil && !source.Funcs[selector.Sel.Name]) {
+					return true
+				}
+
+				position := fileSet.Position(callExpr.Pos())
+
+				message := fmt.Sprintf("VIOLATION: %s %s calls non-deterministic %s.%s in %s at %s:%d (%s)", markerName, typeKey, source.Path, selector.Sel.Name, funcDecl.Name.Name, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
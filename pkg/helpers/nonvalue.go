@@ -0,0 +1,122 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// fieldElementTypes returns the element types a field type holds, looking through pointers, slices, arrays and maps,
+// e.g. Customer for *Customer, []Customer or map[string]Customer.
+func fieldElementTypes(fieldType ast.Expr) []ast.Expr {
+	switch typ := ast.Unparen(fieldType).(type) {
+	case *ast.StarExpr:
+		return fieldElementTypes(typ.X)
+	case *ast.ArrayType:
+		return fieldElementTypes(typ.Elt)
+	case *ast.MapType:
+		return append(fieldElementTypes(typ.Key), fieldElementTypes(typ.Value)...)
+	default:
+		return []ast.Expr{typ}
+	}
+}
+
+// FindNonValueFields scans SomeObject structs for fields holding concrete struct types of the project
+// that are not SomeObjects themselves, e.g. a Value Object holding a Customer struct, which pulls
+// mutable state into a supposed value. Primitives, types of other modules and interfaces are not flagged.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - markerField: The name of the marker field, usually "_"
+//   - typeDeclarations: A map of SomeObjects type names whose fields are scanned
+//   - valueTypes: A map of type names allowed as fields, usually every discovered SomeObject
+//
+// Returns:
+//   - A map of violation messages to non-value field violations
+//   - An error if the scan fails, nil otherwise
+func FindNonValueFields(walk Walker, checkName string, markerName string, markerField string, typeDeclarations map[string]bool, valueTypes map[string]bool) (map[string]*Violation, error) {
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	structTypes := make(map[string]bool)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+
+				if _, ok := ast.Unparen(typeSpec.Type).(*ast.StructType); ok {
+					structTypes[currentPackage+"."+typeSpec.Name.Name] = true
+				}
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	violations := make(map[string]*Violation)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			typeKey := currentPackage + "." + typeSpec.Name.Name
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok || structType.Fields == nil || !typeDeclarations[typeKey] {
+				return true
+			}
+
+			for _, field := range structType.Fields.List {
+				for _, elementType := range fieldElementTypes(field.Type) {
+					fieldTypeKey, ok := resolveTypeKey(file, currentPackage, packages, elementType)
+					if !ok || !structTypes[fieldTypeKey] || valueTypes[fieldTypeKey] {
+						continue
+					}
+
+					position := fileSet.Position(field.Pos())
+
+					for _, name := range fieldDisplayNames(field) {
+						if name == markerField {
+							continue
+						}
+
+						message := fmt.Sprintf("VIOLATION: Field %s of %s %s holds non-value struct %s at %s:%d (%s)", name, markerName, typeKey, fieldTypeKey, path, position.Line, checkName)
+						violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+					}
+				}
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,1120 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// Options controls how validation results are evaluated.
+//
+// Fields:
+//   - MinSeverity: The lowest severity that makes a report fail
+//   - Ignore: Glob patterns of files and directories whose violations are not reported
+//   - Checks: Enabled check identifiers, nil enables every check that is not opt-in
+//   - EnabledChecks: Opt-in check identifiers to run in addition to the default ones
+//   - Severities: Severity overrides per check identifier
+//   - MarkerPackages: Marker package path overrides per stereotype name
+//   - AlternativeMarkers: Further markers accepted per stereotype name, see WithAlternativeMarkers
+//   - ModulePaths: Module path remaps applied to the default marker packages, see WithModulePath
+//   - TypeNames: Type names in format "package.TypeName" or "import/path.TypeName" to restrict validation to, nil validates every type
+//   - RootPath: The scanned root, used to match ignore patterns against relative paths
+//   - Progress: Optional callback reporting how many files have been parsed
+//   - Packages: Package patterns such as "./internal/domain/..." violations are reported for, nil reports every package
+//   - StrictConstructorScope: When true, function literals nested in constructors are not constructor scope
+//   - RelativeTo: The base path violation and constructor paths are rendered relative to, defaults to RootPath
+//   - ContextRoot: The directory whose subdirectories are bounded contexts, relative to RootPath, defaults to RootPath
+//   - Metrics: Collected timings when enabled with WithMetrics, nil otherwise
+//   - TypeInfo: When true, markers are recognised with go/types, see WithTypeInfo
+//   - Baseline: Path of a baseline file whose accepted violations are not reported, see WithBaseline
+//   - SkipGenerated: When true, violations in generated files are not reported
+//   - GeneratedChecks: Check identifiers still reported in generated files, nil reports every check
+//   - PackageOverrides: Severity overrides per package pattern, see WithPackageOverrides
+//   - ErrorTypes: Type names in format "package.TypeName" allowed to implement the error interface
+//   - WarningsAsErrors: When true, warning violations are reported as errors
+//   - Suggestions: When true, violations carry a suggested fix, see WithSuggestions
+//   - AddedLines: Added line numbers per slash-separated file path relative to RootPath, nil reports every line
+//   - TypeChecks: Custom checks run on every discovered type, see WithTypeCheck
+//   - ExportedOnly: When true, only exported types are validated, see WithExportedOnly
+//   - InterfaceFactories: When true, interface-returning factories count as constructors, see WithInterfaceFactories
+//   - MaxValueObjectFields: The largest number of data fields of a Value Object, 0 disables the limit
+//   - BuildTags: The active build tags files are matched against, nil scans every file, see WithBuildTags
+//   - StrictScan: When true, files and directories that cannot be read fail the report, see WithStrictScan
+//   - Entrypoint: The import path of the package whose import closure is validated, empty validates every file, see WithEntrypoint
+//   - DomainPackages: Package patterns of the domain layer used when a layer validation is given none, see WithDomainPackages
+type Options struct {
+	MinSeverity            Severity
+	Ignore                 []string
+	Checks                 map[string]bool
+	EnabledChecks          map[string]bool
+	Severities             map[string]Severity
+	MarkerPackages         map[string]string
+	AlternativeMarkers     map[string][]Marker
+	ModulePaths            map[string]string
+	TypeNames              map[string]bool
+	RootPath               string
+	Progress               ProgressFunc
+	RelativeTo             string
+	Packages               []string
+	StrictConstructorScope bool
+	ContextRoot            string
+	Metrics                *Metrics
+	TypeInfo               bool
+	Baseline               string
+	SkipGenerated          bool
+	GeneratedChecks        map[string]bool
+	PackageOverrides       map[string]SeverityOverride
+	ErrorTypes             map[string]bool
+	WarningsAsErrors       bool
+	Suggestions            bool
+	AddedLines             map[string]map[int]bool
+	TypeChecks             []TypeCheckHook
+	ExportedOnly           bool
+	InterfaceFactories     bool
+	MaxValueObjectFields   int
+	BuildTags              []string
+	StrictScan             bool
+	Entrypoint             string
+	DomainPackages         []string
+
+	parsed      []*SourceFile
+	files       []*SourceFile
+	scanErrors  []ScanError
+	generated   map[string]bool
+	importPaths map[string]string
+	typeInfos   map[*ast.File]*types.Info
+	baseline    map[BaselineEntry]bool
+	declared    map[*ast.StructType]bool
+	shared      *SharedParse
+}
+
+// ProgressFunc receives the number of parsed files and the total number of files,
+// total is -1 when it could not be computed.
+type ProgressFunc func(scanned int, total int)
+
+// Option configures Options.
+type Option func(*Options)
+
+// NewOptions builds Options from defaults and the given option functions.
+//
+// Parameters:
+//   - opts: Option functions applied in order
+//
+// Returns:
+//   - The resulting options
+func NewOptions(opts ...Option) *Options {
+	options := &Options{
+		MinSeverity:        SeverityInfo,
+		EnabledChecks:      make(map[string]bool),
+		Severities:         make(map[string]Severity),
+		MarkerPackages:     make(map[string]string),
+		AlternativeMarkers: make(map[string][]Marker),
+		ModulePaths:        make(map[string]string),
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return options
+}
+
+// LoadOptions builds Options for a scan of rootPath.
+// A configuration file found by FindConfigFile is applied first,
+// so the explicitly given option functions override its values.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan
+//   - opts: Option functions applied after the configuration file
+//
+// Returns:
+//   - The resulting options
+//   - An error wrapping ErrInvalidConfig if the configuration or baseline file cannot be loaded, nil otherwise
+func LoadOptions(rootPath string, opts ...Option) (*Options, error) {
+	var all []Option
+
+	fileName, err := FindConfigFile(rootPath)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	if fileName != "" {
+		config, err := LoadConfig(fileName)
+		if err != nil {
+			return nil, ge.Pin(fmt.Errorf("%w: %w", ErrInvalidConfig, err))
+		}
+
+		configOpts, err := config.Options()
+		if err != nil {
+			return nil, ge.Pin(fmt.Errorf("%w: %w", ErrInvalidConfig, err), ge.Params{"fileName": fileName})
+		}
+
+		all = append(all, configOpts...)
+	}
+
+	all = append(all, opts...)
+
+	options := NewOptions(all...)
+	options.RootPath = rootPath
+
+	if options.RelativeTo == "" {
+		options.RelativeTo = rootPath
+	}
+
+	if options.Baseline != "" {
+		options.baseline, err = LoadBaseline(options.Baseline)
+		if err != nil {
+			return nil, ge.Pin(fmt.Errorf("%w: %w", ErrInvalidConfig, err))
+		}
+	}
+
+	return options, nil
+}
+
+// IsCheckEnabled reports whether violations of a check should be reported.
+//
+// Parameters:
+//   - check: The check identifier
+//
+// Returns:
+//   - true if the check is in the configured check list, or no list is configured
+//     and the check is either not opt-in or explicitly enabled, false otherwise
+func (o *Options) IsCheckEnabled(check string) bool {
+	if o.Checks != nil {
+		return o.Checks[check]
+	}
+
+	return !IsOptInCheck(check) || o.EnabledChecks[check]
+}
+
+// MarkerPackage returns the marker package path for a stereotype.
+//
+// Parameters:
+//   - declaredName: The stereotype marker name, e.g. "ValueObject"
+//   - defaultPackage: The package path used when no override is configured
+//
+// Returns:
+//   - The configured marker package path or defaultPackage, remapped to another module path when configured
+func (o *Options) MarkerPackage(declaredName string, defaultPackage string) string {
+	fullPackage, ok := o.MarkerPackages[declaredName]
+	if !ok {
+		return o.remapModulePath(defaultPackage)
+	}
+
+	return fullPackage
+}
+
+// remapModulePath replaces the longest configured module path an import path belongs to with its remap.
+func (o *Options) remapModulePath(importPath string) string {
+	from := ""
+
+	for modulePath := range o.ModulePaths {
+		if (importPath == modulePath || strings.HasPrefix(importPath, modulePath+"/")) && len(modulePath) > len(from) {
+			from = modulePath
+		}
+	}
+
+	if from == "" {
+		return importPath
+	}
+
+	return o.ModulePaths[from] + strings.TrimPrefix(importPath, from)
+}
+
+// Walker returns a Walker over rootPath that parses the files once,
+// reporting progress, and reuses them on every following walk.
+// Files given with WithParsedFiles or WithParsedPackages are walked instead of parsing rootPath,
+// and the files are restricted to the import closure of the entrypoint given with WithEntrypoint.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//
+// Returns:
+//   - The walker
+func (o *Options) Walker(rootPath string) Walker {
+	return func(visit FileVisitor) error {
+		if o.files == nil {
+			files := o.parsed
+
+			if files == nil {
+				parsed, err := o.parse(rootPath)
+				if err != nil {
+					return ge.Pin(err)
+				}
+
+				files = parsed
+			}
+
+			if o.BuildTags != nil {
+				files = MatchBuildTags(files, o.BuildTags)
+			}
+
+			if o.Entrypoint != "" {
+				reachable, err := ReachableFiles(files, o.Entrypoint)
+				if err != nil {
+					return ge.Pin(err)
+				}
+
+				files = reachable
+			}
+
+			o.files = files
+			o.generated = make(map[string]bool)
+
+			for _, file := range files {
+				if ast.IsGenerated(file.File) {
+					o.generated[file.Path] = true
+				}
+			}
+
+			if o.TypeInfo {
+				o.typeInfos = TypeCheck(files)
+			}
+
+			if o.Metrics != nil {
+				o.Metrics.Files = len(files)
+			}
+		}
+
+		defer o.Metrics.Track(PhaseWalk)()
+
+		return NewFilesWalker(o.files)(visit)
+	}
+}
+
+// parse parses the files under rootPath, reporting progress and collecting the paths that cannot be read,
+// or reuses the files of an earlier run given the same SharedParse, see WithSharedParse.
+func (o *Options) parse(rootPath string) ([]*SourceFile, error) {
+	if o.shared != nil {
+		o.shared.mutex.Lock()
+		defer o.shared.mutex.Unlock()
+
+		if o.shared.done {
+			o.scanErrors = append(o.scanErrors, o.shared.scanErrors...)
+
+			return o.shared.files, nil
+		}
+	}
+
+	var scanErrors []ScanError
+
+	stop := o.Metrics.Track(PhaseParse)
+	parsed, err := ParseGoFilesReportingErrors(rootPath, o.Progress, func(scanError ScanError) {
+		scanErrors = append(scanErrors, scanError)
+	})
+	stop()
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	o.scanErrors = append(o.scanErrors, scanErrors...)
+
+	if o.shared != nil {
+		o.shared.files = parsed
+		o.shared.scanErrors = scanErrors
+		o.shared.done = true
+	}
+
+	return parsed, nil
+}
+
+// SharedParse holds the files of a root parsed by the first of several runs given it with WithSharedParse,
+// so the following runs neither parse the root again nor report progress from zero again.
+type SharedParse struct {
+	mutex      sync.Mutex
+	files      []*SourceFile
+	scanErrors []ScanError
+	done       bool
+}
+
+// NewSharedParse returns an empty SharedParse, the first run given it parses the root.
+//
+// Returns:
+//   - The shared parse
+func NewSharedParse() *SharedParse {
+	return &SharedParse{}
+}
+
+// WithSharedParse parses the scanned root once for every run given the same shared parse, e.g. the validators
+// of several stereotypes over one root, see validator.Validate. All runs must scan the same root,
+// files given with WithParsedFiles or WithParsedPackages take precedence.
+//
+// Parameters:
+//   - shared: The shared parse
+//
+// Returns:
+//   - The option function
+func WithSharedParse(shared *SharedParse) Option {
+	return func(o *Options) {
+		o.shared = shared
+	}
+}
+
+// ImportsAnyMarker reports whether the project imports the package of any of the given markers, see ImportsAnyPackage.
+// Files already parsed, given with WithParsedFiles or parsed by an earlier run sharing the parse, see WithSharedParse,
+// are checked instead of scanning rootPath again.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - markers: The markers whose packages to look for, see Markers
+//
+// Returns:
+//   - true if a file imports one of the marker packages, false otherwise
+//   - An error wrapping ErrScanFailed if the walk fails, nil otherwise
+func (o *Options) ImportsAnyMarker(rootPath string, markers []Marker) (bool, error) {
+	importPaths := make([]string, 0, len(markers))
+	for _, marker := range markers {
+		importPaths = append(importPaths, marker.FullPackage)
+	}
+
+	files := o.files
+	if files == nil {
+		files = o.parsed
+	}
+
+	if files == nil && o.shared != nil {
+		o.shared.mutex.Lock()
+		files = o.shared.files
+		o.shared.mutex.Unlock()
+	}
+
+	if files == nil {
+		found, err := ImportsAnyPackage(rootPath, importPaths)
+		if err != nil {
+			return false, ge.Pin(err)
+		}
+
+		return found, nil
+	}
+
+	for _, file := range files {
+		if importsAny(file.File, importPaths) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// WithMinSeverity sets the lowest severity that makes a report fail.
+// Violations below it are still reported and counted.
+//
+// Parameters:
+//   - severity: The failure threshold
+//
+// Returns:
+//   - The option function
+func WithMinSeverity(severity Severity) Option {
+	return func(o *Options) {
+		o.MinSeverity = severity
+	}
+}
+
+// WithIgnore adds glob patterns of files and directories whose violations are not reported.
+// Patterns are matched against slash-separated paths relative to the scan root and
+// each of their parent directories; patterns without a slash also match file names.
+//
+// Parameters:
+//   - patterns: Glob patterns in filepath.Match syntax
+//
+// Returns:
+//   - The option function
+func WithIgnore(patterns ...string) Option {
+	return func(o *Options) {
+		o.Ignore = append(o.Ignore, patterns...)
+	}
+}
+
+// WithChecks enables only the listed checks, replacing any previously enabled set.
+//
+// Parameters:
+//   - checks: The check identifiers to enable
+//
+// Returns:
+//   - The option function
+func WithChecks(checks ...string) Option {
+	return func(o *Options) {
+		o.Checks = make(map[string]bool, len(checks))
+
+		for _, check := range checks {
+			o.Checks[check] = true
+		}
+	}
+}
+
+// WithEnabledChecks turns on opt-in checks in addition to the default ones.
+//
+// Parameters:
+//   - checks: The opt-in check identifiers to enable
+//
+// Returns:
+//   - The option function
+func WithEnabledChecks(checks ...string) Option {
+	return func(o *Options) {
+		for _, check := range checks {
+			o.EnabledChecks[check] = true
+		}
+	}
+}
+
+// WithTypeNames restricts discovery, constructor detection and violation reporting to the listed types.
+//
+// Parameters:
+//   - typeNames: Type names in format "package.TypeName" or "import/path.TypeName", see SplitTypeKey
+//
+// Returns:
+//   - The option function
+func WithTypeNames(typeNames ...string) Option {
+	return func(o *Options) {
+		if o.TypeNames == nil {
+			o.TypeNames = make(map[string]bool, len(typeNames))
+		}
+
+		for _, typeName := range typeNames {
+			o.TypeNames[typeName] = true
+		}
+	}
+}
+
+// WithProgress sets a callback invoked after each parsed file.
+// It is purely observational and does not affect results.
+//
+// Parameters:
+//   - progress: The callback receiving the scanned and total file counts
+//
+// Returns:
+//   - The option function
+func WithProgress(progress ProgressFunc) Option {
+	return func(o *Options) {
+		o.Progress = progress
+	}
+}
+
+// WithPackages restricts reported violations to packages matching go tool style patterns,
+// e.g. "./internal/domain/...". Types are still discovered in the whole scan root,
+// so uses of types declared elsewhere are validated inside the selected packages.
+//
+// Parameters:
+//   - patterns: Package patterns relative to the scan root
+//
+// Returns:
+//   - The option function
+func WithPackages(patterns ...string) Option {
+	return func(o *Options) {
+		o.Packages = append(o.Packages, patterns...)
+	}
+}
+
+// WithStrictConstructorScope treats only statements directly in a constructor body as constructor scope,
+// so zero values created inside closures defined in a constructor are reported.
+// By default the whole constructor line range, closures included, is in scope.
+//
+// Returns:
+//   - The option function
+func WithStrictConstructorScope() Option {
+	return func(o *Options) {
+		o.StrictConstructorScope = true
+	}
+}
+
+// WithContextRoot sets the directory whose immediate subdirectories are the bounded contexts,
+// e.g. "internal" for a layout of internal/billing and internal/shipping.
+//
+// Parameters:
+//   - dir: The directory, relative to the scan root
+//
+// Returns:
+//   - The option function
+func WithContextRoot(dir string) Option {
+	return func(o *Options) {
+		o.ContextRoot = dir
+	}
+}
+
+// BoundedContextRoot returns the directory whose subdirectories are the bounded contexts.
+//
+// Returns:
+//   - ContextRoot resolved against RootPath
+func (o *Options) BoundedContextRoot() string {
+	if filepath.IsAbs(o.ContextRoot) {
+		return o.ContextRoot
+	}
+
+	return filepath.Join(o.RootPath, o.ContextRoot)
+}
+
+// WithTypeInfo recognises stereotype markers by their resolved type instead of by import alias.
+//
+// Two detection modes exist:
+//   - AST mode, the default: fast and works on code that does not compile, but matches the marker
+//     through the import aliases of the file, so unusual imports such as dot imports are missed
+//   - Typed mode, this option: type-checks every package with go/types and compares the marker's
+//     full package path and name, so aliases, dot imports and shadowing do not matter; it is much slower,
+//     as imports are type-checked from source, and needs resolvable dependencies
+//
+// Struct fields without type information, e.g. in packages that fail to type-check,
+// fall back to the AST mode. Only type discovery uses the typed mode, the checks stay AST based.
+//
+// Returns:
+//   - The option function
+func WithTypeInfo() Option {
+	return func(o *Options) {
+		o.TypeInfo = true
+	}
+}
+
+// WithBaseline suppresses the violations accepted in a baseline file, see Baseline,
+// so only new violations are reported. An empty path disables a configured baseline.
+//
+// Parameters:
+//   - fileName: The baseline file path
+//
+// Returns:
+//   - The option function
+func WithBaseline(fileName string) Option {
+	return func(o *Options) {
+		o.Baseline = fileName
+	}
+}
+
+// IsBaselined reports whether a violation is accepted by the loaded baseline.
+// Baselines written before type names were qualified with import paths are still honoured.
+//
+// Parameters:
+//   - violation: The violation to check
+//
+// Returns:
+//   - true if the baseline lists the violation's type and check, false otherwise
+func (o *Options) IsBaselined(violation *Violation) bool {
+	return o.baseline[BaselineEntry{Check: violation.Check, TypeName: violation.TypeName}] ||
+		o.baseline[BaselineEntry{Check: violation.Check, TypeName: shortTypeKey(violation.TypeName)}]
+}
+
+// WithSkipGenerated stops reporting violations in generated files,
+// recognised by the standard "// Code generated ... DO NOT EDIT." header.
+// Types declared in generated files are still discovered.
+//
+// Returns:
+//   - The option function
+func WithSkipGenerated() Option {
+	return func(o *Options) {
+		o.SkipGenerated = true
+	}
+}
+
+// WithGeneratedChecks applies a relaxed ruleset to generated files:
+// only violations of the given checks are reported in them.
+//
+// Parameters:
+//   - checks: Check identifiers reported in generated files
+//
+// Returns:
+//   - The option function
+func WithGeneratedChecks(checks ...string) Option {
+	return func(o *Options) {
+		if o.GeneratedChecks == nil {
+			o.GeneratedChecks = make(map[string]bool)
+		}
+
+		for _, check := range checks {
+			o.GeneratedChecks[check] = true
+		}
+	}
+}
+
+// WithAddedLines restricts reported violations to the added lines of a diff, e.g. of a pull request,
+// so pre-existing debt is not reported again. Types and constructors are still discovered in the whole scan root.
+//
+// Parameters:
+//   - addedLines: Added line numbers per file path relative to the scan root, e.g. "internal/money/money.go"
+//
+// Returns:
+//   - The option function
+func WithAddedLines(addedLines map[string][]int) Option {
+	return func(o *Options) {
+		if o.AddedLines == nil {
+			o.AddedLines = make(map[string]map[int]bool, len(addedLines))
+		}
+
+		for file, lines := range addedLines {
+			file = path.Clean(filepath.ToSlash(file))
+
+			if o.AddedLines[file] == nil {
+				o.AddedLines[file] = make(map[int]bool, len(lines))
+			}
+
+			for _, line := range lines {
+				o.AddedLines[file][line] = true
+			}
+		}
+	}
+}
+
+// IsOnAddedLine reports whether a violation is on one of the lines selected with WithAddedLines.
+//
+// Parameters:
+//   - violation: The violation to check
+//
+// Returns:
+//   - true if lines are not restricted or the violation's line was added, false otherwise
+func (o *Options) IsOnAddedLine(violation *Violation) bool {
+	if o.AddedLines == nil {
+		return true
+	}
+
+	file := filepath.ToSlash(RelativePath(o.RootPath, violation.File))
+
+	return o.AddedLines[file][violation.Line]
+}
+
+// IsReportedInGeneratedFile reports whether a violation passes the generated file rules.
+//
+// Parameters:
+//   - violation: The violation to check
+//
+// Returns:
+//   - true if the violation is not in a generated file, or generated files are reported
+//     and the check belongs to the generated file ruleset, false otherwise
+func (o *Options) IsReportedInGeneratedFile(violation *Violation) bool {
+	if !o.generated[violation.File] {
+		return true
+	}
+
+	if o.SkipGenerated {
+		return false
+	}
+
+	return o.GeneratedChecks == nil || o.GeneratedChecks[violation.Check]
+}
+
+// WithPackageOverrides downgrades or suppresses violations in matching packages,
+// e.g. to keep legacy packages lenient while new code stays strict.
+// Patterns starting with "./" match directories relative to the scan root,
+// other patterns match import paths; both accept a "/..." suffix.
+// When several patterns match, the longest one wins.
+//
+// Parameters:
+//   - overrides: Overrides per package pattern, see Downgrade and Suppress
+//
+// Returns:
+//   - The option function
+func WithPackageOverrides(overrides map[string]SeverityOverride) Option {
+	return func(o *Options) {
+		if o.PackageOverrides == nil {
+			o.PackageOverrides = make(map[string]SeverityOverride)
+		}
+
+		for pattern, override := range overrides {
+			o.PackageOverrides[pattern] = override
+		}
+	}
+}
+
+// WithErrorTypes allows stereotype types to implement the error interface,
+// so the stereotype-implements-error check does not report them.
+//
+// Parameters:
+//   - typeNames: Type names in format "package.TypeName"
+//
+// Returns:
+//   - The option function
+func WithErrorTypes(typeNames ...string) Option {
+	return func(o *Options) {
+		if o.ErrorTypes == nil {
+			o.ErrorTypes = make(map[string]bool)
+		}
+
+		for _, typeName := range typeNames {
+			o.ErrorTypes[typeName] = true
+		}
+	}
+}
+
+// WithWarningsAsErrors reports every warning violation as an error, so warnings fail the run
+// whatever the minimum severity is. Check severity overrides are applied first,
+// package overrides still cap the promoted severity.
+//
+// Parameters:
+//   - enabled: Whether warnings are promoted to errors
+//
+// Returns:
+//   - The option function
+func WithWarningsAsErrors(enabled bool) Option {
+	return func(o *Options) {
+		o.WarningsAsErrors = enabled
+	}
+}
+
+// WithStrictScan fails the report when files or directories below the scanned root cannot be read,
+// e.g. because of missing permissions, instead of only listing them in Report.ScanErrors.
+//
+// Parameters:
+//   - enabled: Whether unreadable paths fail the report
+//
+// Returns:
+//   - The option function
+func WithStrictScan(enabled bool) Option {
+	return func(o *Options) {
+		o.StrictScan = enabled
+	}
+}
+
+// WithEntrypoint restricts discovery and violations to the packages an entrypoint package imports,
+// directly or transitively within its module, e.g. the root package of one bounded context, see ReachableFiles.
+// Validation fails with ErrEntrypointNotFound when no scanned file belongs to the entrypoint.
+//
+// Parameters:
+//   - importPath: The import path of the entrypoint package, e.g. "example.com/shop/ordering"
+//
+// Returns:
+//   - The option function
+func WithEntrypoint(importPath string) Option {
+	return func(o *Options) {
+		o.Entrypoint = importPath
+	}
+}
+
+// WithDomainPackages sets the package patterns of the domain layer,
+// used by the layer validations of the layers package when they are not given a layer configuration of their own.
+//
+// Parameters:
+//   - patterns: Package patterns such as "./internal/domain/..."
+//
+// Returns:
+//   - The option function
+func WithDomainPackages(patterns ...string) Option {
+	return func(o *Options) {
+		o.DomainPackages = patterns
+	}
+}
+
+// WithExportedOnly restricts validation to exported stereotype types, the public domain API,
+// so unexported types are neither discovered nor reported. Violations not tied to a type are still reported.
+//
+// Parameters:
+//   - enabled: Whether only exported types are validated
+//
+// Returns:
+//   - The option function
+func WithExportedOnly(enabled bool) Option {
+	return func(o *Options) {
+		o.ExportedOnly = enabled
+	}
+}
+
+// WithInterfaceFactories treats "New" functions returning an interface, or any other type than a stereotype,
+// as constructors of the stereotype types they return as composite literals, see FactoryTypeKeys.
+// Zero values built inside such factories of ports and adapters are then in constructor scope.
+//
+// Returns:
+//   - The option function
+func WithInterfaceFactories() Option {
+	return func(o *Options) {
+		o.InterfaceFactories = true
+	}
+}
+
+// WithMaxValueObjectFields reports Value Objects with more than n data fields, the marker not counted,
+// as large-value-object, suggesting a decomposition. A limit of 0 disables the check.
+//
+// Parameters:
+//   - n: The largest allowed number of data fields
+//
+// Returns:
+//   - The option function
+func WithMaxValueObjectFields(n int) Option {
+	return func(o *Options) {
+		o.MaxValueObjectFields = n
+	}
+}
+
+// WithBuildTags scans only the files a build with the given tags for the current platform includes,
+// so code gated behind inactive tags such as `//go:build enterprise` is not validated.
+// Without this option every file is scanned, whatever its build constraints.
+//
+// Parameters:
+//   - tags: The active build tags
+//
+// Returns:
+//   - The option function
+func WithBuildTags(tags ...string) Option {
+	return func(o *Options) {
+		o.BuildTags = append([]string{}, tags...)
+	}
+}
+
+// WithSuggestions attaches a short suggested fix to every violation, such as
+// "use NewLocation(...) instead of Location{}", naming the discovered constructors of the type.
+//
+// Returns:
+//   - The option function
+func WithSuggestions() Option {
+	return func(o *Options) {
+		o.Suggestions = true
+	}
+}
+
+// WithTypeCheck registers a custom check run on every discovered SomeObject type during type discovery,
+// so organisation-specific conventions can be enforced without forking.
+// Its violations are merged into the report and filtered like the built-in ones;
+// their severity is taken as returned, use NewViolation for the severity registered for the check.
+//
+// Parameters:
+//   - check: The custom check
+//
+// Returns:
+//   - The option function
+func WithTypeCheck(check TypeCheckHook) Option {
+	return func(o *Options) {
+		o.TypeChecks = append(o.TypeChecks, check)
+	}
+}
+
+// WithMetrics records per-phase durations and the file count of the run in Report.Metrics.
+//
+// Returns:
+//   - The option function
+func WithMetrics() Option {
+	return func(o *Options) {
+		o.Metrics = &Metrics{}
+	}
+}
+
+// WithRelativeTo renders violation and constructor file paths relative to base
+// instead of the scan root, keeping reports machine-independent.
+//
+// Parameters:
+//   - base: The base path
+//
+// Returns:
+//   - The option function
+func WithRelativeTo(base string) Option {
+	return func(o *Options) {
+		o.RelativeTo = base
+	}
+}
+
+// WithSeverity overrides the severity reported for a check.
+//
+// Parameters:
+//   - check: The check identifier
+//   - severity: The severity to report
+//
+// Returns:
+//   - The option function
+func WithSeverity(check string, severity Severity) Option {
+	return func(o *Options) {
+		o.Severities[check] = severity
+	}
+}
+
+// WithMarkerPackage overrides the package path a stereotype marker is imported from.
+//
+// Parameters:
+//   - declaredName: The stereotype marker name, e.g. "ValueObject"
+//   - fullPackage: The full import path of the marker package
+//
+// Returns:
+//   - The option function
+func WithMarkerPackage(declaredName string, fullPackage string) Option {
+	return func(o *Options) {
+		o.MarkerPackages[declaredName] = fullPackage
+	}
+}
+
+// WithModulePath remaps the module path of the default marker packages, e.g. when the scanned code,
+// such as a vendored shared domain library, imports the markers from a copy of dddgo under its own module path.
+// Unlike WithMarkerPackage it applies to every stereotype, registered ones included, whose marker lives in the module.
+//
+// Parameters:
+//   - from: The module path the markers are declared under, e.g. "github.com/nobuenhombre/dddgo"
+//   - to: The module path the scanned code imports them from, e.g. "github.com/acme/domain/third_party/dddgo"
+//
+// Returns:
+//   - The option function
+func WithModulePath(from string, to string) Option {
+	return func(o *Options) {
+		o.ModulePaths[from] = to
+	}
+}
+
+// WithParsedFiles validates files parsed elsewhere, e.g. by go/packages, instead of parsing the files under the scanned root.
+// The root still anchors ignore patterns, package patterns and relative paths, and test files are still read from it.
+//
+// Parameters:
+//   - fileSet: The file set the files were parsed with, shared by all of them
+//   - files: The parsed files
+//
+// Returns:
+//   - The option function
+func WithParsedFiles(fileSet *token.FileSet, files ...*ast.File) Option {
+	return func(o *Options) {
+		o.parsed = ParsedFiles(fileSet, files...)
+	}
+}
+
+// WithParsedPackages validates packages parsed elsewhere instead of parsing the files under the scanned root, see WithParsedFiles.
+//
+// Parameters:
+//   - fileSet: The file set the packages were parsed with, shared by all of them
+//   - packages: The parsed packages by name or path
+//
+// Returns:
+//   - The option function
+func WithParsedPackages(fileSet *token.FileSet, packages map[string]*ast.Package) Option {
+	return func(o *Options) {
+		o.parsed = ParsedPackages(fileSet, packages)
+	}
+}
+
+// WithAlternativeMarkers accepts further markers for a stereotype besides its own,
+// so types marked with either are validated while a marker moves to a new package.
+//
+// Parameters:
+//   - declaredName: The stereotype marker name, e.g. "ValueObject"
+//   - markers: The alternative markers, those without a name are named declaredName
+//
+// Returns:
+//   - The option function
+func WithAlternativeMarkers(declaredName string, markers ...Marker) Option {
+	return func(o *Options) {
+		o.AlternativeMarkers[declaredName] = append(o.AlternativeMarkers[declaredName], markers...)
+	}
+}
+
+// IsTypeIncluded reports whether validation covers a type.
+// Listed names qualified with an import path also include the short "package.TypeName" key used during discovery.
+//
+// Parameters:
+//   - typeName: The type name in format "package.TypeName" or "import/path.TypeName"
+//
+// Returns:
+//   - true if validation is not restricted or the type is listed, false otherwise;
+//     with WithExportedOnly unexported types are never included
+func (o *Options) IsTypeIncluded(typeName string) bool {
+	if o.ExportedOnly && typeName != "" {
+		if _, name := SplitTypeKey(typeName); !token.IsExported(name) {
+			return false
+		}
+	}
+
+	if o.TypeNames == nil || o.TypeNames[typeName] || o.TypeNames[shortTypeKey(typeName)] {
+		return true
+	}
+
+	for listed := range o.TypeNames {
+		if shortTypeKey(listed) == typeName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FilterTypes drops the discovered types that validation is not restricted to.
+//
+// Parameters:
+//   - types: Discovered type names
+//
+// Returns:
+//   - The types to validate
+func (o *Options) FilterTypes(types map[string]bool) map[string]bool {
+	if o.TypeNames == nil && !o.ExportedOnly {
+		return types
+	}
+
+	filtered := make(map[string]bool)
+
+	for typeName, declared := range types {
+		if o.IsTypeIncluded(typeName) {
+			filtered[typeName] = declared
+		}
+	}
+
+	return filtered
+}
+
+// Markers returns the markers accepted for a stereotype: its marker, honouring the configured marker package override,
+// followed by the given and the configured alternative markers.
+//
+// Parameters:
+//   - declaredName: The stereotype marker name, e.g. "ValueObject"
+//   - defaultPackage: The package path used when no override is configured
+//   - alternatives: Further markers accepted for the stereotype
+//
+// Returns:
+//   - The accepted markers, alternatives without a name named declaredName
+func (o *Options) Markers(declaredName string, defaultPackage string, alternatives ...Marker) []Marker {
+	markers := []Marker{{FullPackage: o.MarkerPackage(declaredName, defaultPackage), DeclaredName: declaredName}}
+
+	for _, marker := range append(append([]Marker{}, alternatives...), o.AlternativeMarkers[declaredName]...) {
+		if marker.DeclaredName == "" {
+			marker.DeclaredName = declaredName
+		}
+
+		markers = append(markers, marker)
+	}
+
+	return markers
+}
+
+// TypeDeclaration returns a predicate recognising structs marked with a stereotype,
+// honouring the configured marker package override and accepting the alternative markers as well, see Markers.
+// With WithTypeInfo the marker is resolved with go/types where type information is available,
+// falling back to matching import aliases in the AST otherwise.
+// Matched structs are recorded, so reports only qualify declarations carrying a marker, see declaringDirs.
+//
+// Parameters:
+//   - fullPackage: The default full package path of the marker
+//   - markerField: The name of the marker field, usually "_"
+//   - declaredName: The stereotype marker name, e.g. "ValueObject"
+//   - alternatives: Further markers accepted for the stereotype, e.g. its previous location during a migration
+//
+// Returns:
+//   - The type declaration predicate
+func (o *Options) TypeDeclaration(fullPackage string, markerField string, declaredName string, alternatives ...Marker) IsTypeDeclaration {
+	markers := o.Markers(declaredName, fullPackage, alternatives...)
+
+	matches := func(file *ast.File, structType *ast.StructType) bool {
+		if info, ok := o.typeInfos[file]; ok {
+			allKnown := true
+
+			for _, marker := range markers {
+				isMarked, known := isTypedSomeObjectTypeDeclaration(info, structType, marker.FullPackage, markerField, marker.DeclaredName)
+				if isMarked {
+					return true
+				}
+
+				allKnown = allKnown && known
+			}
+
+			if allKnown {
+				return false
+			}
+		}
+
+		return IsAnySomeObjectTypeDeclaration(file, structType, markers, markerField)
+	}
+
+	return func(file *ast.File, structType *ast.StructType) bool {
+		if !matches(file, structType) {
+			return false
+		}
+
+		if o.declared == nil {
+			o.declared = make(map[*ast.StructType]bool)
+		}
+
+		o.declared[structType] = true
+
+		return true
+	}
+}
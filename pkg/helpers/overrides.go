@@ -0,0 +1,112 @@
+package helpers
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// SeverityOverride lowers or suppresses violations in a set of packages.
+//
+// Fields:
+//   - Severity: The highest severity reported in the packages, more severe violations are downgraded to it
+//   - Suppress: When true, violations in the packages are not reported at all
+type SeverityOverride struct {
+	Severity Severity
+	Suppress bool
+}
+
+// Downgrade returns an override lowering violations to severity.
+//
+// Parameters:
+//   - severity: The highest severity to report
+//
+// Returns:
+//   - The override
+func Downgrade(severity Severity) SeverityOverride {
+	return SeverityOverride{Severity: severity}
+}
+
+// Suppress returns an override dropping every violation.
+//
+// Returns:
+//   - The override
+func Suppress() SeverityOverride {
+	return SeverityOverride{Suppress: true}
+}
+
+// ParseSeverityOverride converts a severity name or "off" into a SeverityOverride.
+//
+// Parameters:
+//   - name: One of "info", "warning", "error" or "off"
+//
+// Returns:
+//   - The parsed override
+//   - An error if the name is unknown, nil otherwise
+func ParseSeverityOverride(name string) (SeverityOverride, error) {
+	if name == "off" {
+		return Suppress(), nil
+	}
+
+	severity, err := ParseSeverity(name)
+	if err != nil {
+		return SeverityOverride{}, ge.Pin(err)
+	}
+
+	return Downgrade(severity), nil
+}
+
+// packageOverride finds the override of the most specific pattern matching a file,
+// either by directory relative to the scan root, e.g. "./legacy/...", or by import path.
+func (o *Options) packageOverride(file string) (SeverityOverride, bool) {
+	var (
+		override SeverityOverride
+		matched  string
+		found    bool
+	)
+
+	for pattern, candidate := range o.PackageOverrides {
+		if !o.matchesPackage(file, pattern) {
+			continue
+		}
+
+		if found && len(pattern) <= len(matched) {
+			continue
+		}
+
+		override, matched, found = candidate, pattern, true
+	}
+
+	return override, found
+}
+
+// matchesPackage reports whether a file belongs to a package matched by a directory or import path pattern.
+func (o *Options) matchesPackage(file string, pattern string) bool {
+	if pattern == "." || strings.HasPrefix(pattern, "./") || strings.HasPrefix(pattern, "../") {
+		return MatchPackagePattern(o.RootPath, file, pattern)
+	}
+
+	importPath := o.importPath(filepath.Dir(file))
+
+	return importPath != "" && MatchImportPattern(importPath, pattern)
+}
+
+// applyPackageOverride applies the package override of the violation's file.
+// It returns false when the violation is suppressed.
+func (o *Options) applyPackageOverride(violation *Violation) bool {
+	override, ok := o.packageOverride(violation.File)
+	if !ok {
+		return true
+	}
+
+	if override.Suppress {
+		return false
+	}
+
+	if violation.Severity > override.Severity {
+		violation.Severity = override.Severity
+	}
+
+	return true
+}
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	packageNamesMutex sync.RWMutex
+
+	// packageNames maps import paths to the names declared by the package clauses of their packages,
+	// see RegisterPackageName.
+	packageNames = make(map[string]string)
+)
+
+// RegisterPackageName records the name declared by the package clause of the package at importPath,
+// so imports of it without an alias resolve to that name rather than the last element of the path,
+// e.g. "valueobject" for the ".../objects/value-object" directory.
+//
+// Parameters:
+//   - importPath: The full import path of the package
+//   - name: The name declared by the package clause
+func RegisterPackageName(importPath string, name string) {
+	packageNamesMutex.Lock()
+	defer packageNamesMutex.Unlock()
+
+	packageNames[importPath] = name
+}
+
+// PackageName returns the name a file importing importPath without an alias refers to the package by:
+// the registered name, see RegisterPackageName, or the last element of the path.
+//
+// Parameters:
+//   - importPath: The full import path of the package
+//
+// Returns:
+//   - The package name
+func PackageName(importPath string) string {
+	packageNamesMutex.RLock()
+	name, ok := packageNames[importPath]
+	packageNamesMutex.RUnlock()
+
+	if ok {
+		return name
+	}
+
+	parts := strings.Split(importPath, "/")
+
+	return parts[len(parts)-1]
+}
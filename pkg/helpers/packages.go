@@ -0,0 +1,96 @@
+package helpers
+
+import (
+	"go/ast"
+	"go/token"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// PackageIndex maps the slash-separated directories of parsed files to the package names they declare.
+// It lets imports be resolved to the real package name when it differs from the directory name.
+// External test packages (package names with the "_test" suffix) are not indexed, so they
+// never shadow the package under test; their own types keep the "_test" suffix in type keys.
+// WalkGoFiles skips test files, they only reach the index through a walker over preparsed files, see NewFilesWalker.
+type PackageIndex map[string]string
+
+// BuildPackageIndex collects the package names declared in every directory of the project.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//
+// Returns:
+//   - The package index
+//   - An error if the scan fails, nil otherwise
+func BuildPackageIndex(walk Walker) (PackageIndex, error) {
+	index := make(PackageIndex)
+
+	err := walk(func(filePath string, fileSet *token.FileSet, file *ast.File) {
+		packageName := file.Name.Name
+		if strings.HasSuffix(packageName, "_test") {
+			return
+		}
+
+		index[filepath.ToSlash(filepath.Dir(filePath))] = packageName
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return index, nil
+}
+
+// PackageName returns the package name declared by the directory an import path points to.
+// The directory sharing the longest trailing run of path elements with the import path wins.
+//
+// Parameters:
+//   - importPath: The import path to resolve
+//
+// Returns:
+//   - The declared package name and true if a directory matches unambiguously
+//   - The last import path element and false otherwise
+func (i PackageIndex) PackageName(importPath string) (string, bool) {
+	importParts := strings.Split(importPath, "/")
+	fallback := importParts[len(importParts)-1]
+
+	best := 0
+	bestName := ""
+	ambiguous := false
+
+	for dir, packageName := range i {
+		matched := commonSuffixLength(importParts, strings.Split(path.Clean(dir), "/"))
+		if matched == 0 {
+			continue
+		}
+
+		switch {
+		case matched > best:
+			best = matched
+			bestName = packageName
+			ambiguous = false
+		case matched == best && packageName != bestName:
+			ambiguous = true
+		}
+	}
+
+	if best == 0 || ambiguous {
+		return fallback, false
+	}
+
+	return bestName, true
+}
+
+// commonSuffixLength counts the trailing elements shared by two paths.
+func commonSuffixLength(a []string, b []string) int {
+	count := 0
+
+	for count < len(a) && count < len(b) && a[len(a)-1-count] == b[len(b)-1-count] {
+		count++
+	}
+
+	return count
+}
@@ -0,0 +1,45 @@
+package helpers
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestBuildPackageIndexSkipsExternalTestPackages(t *testing.T) {
+	fileSet := token.NewFileSet()
+
+	// The external test package comes last, so it would win if it were indexed
+	sources := []struct {
+		path string
+		src  string
+	}{
+		{path: "shop/orders/orders.go", src: "package orders\n\ntype Order struct{}\n"},
+		{path: "shop/orders/orders_test.go", src: "package orders_test\n\nfunc helper() {}\n"},
+	}
+
+	files := make([]*SourceFile, 0, len(sources))
+
+	for _, source := range sources {
+		file, err := parser.ParseFile(fileSet, source.path, source.src, parser.SkipObjectResolution)
+		if err != nil {
+			t.Fatalf("ParseFile(%s) error = %v", source.path, err)
+		}
+
+		files = append(files, &SourceFile{Path: source.path, FileSet: fileSet, File: file})
+	}
+
+	index, err := BuildPackageIndex(NewFilesWalker(files))
+	if err != nil {
+		t.Fatalf("BuildPackageIndex() error = %v", err)
+	}
+
+	if got := index["shop/orders"]; got != "orders" {
+		t.Errorf("index[%q] = %q, want %q", "shop/orders", got, "orders")
+	}
+
+	packageName, ok := index.PackageName("example.com/shop/orders")
+	if !ok || packageName != "orders" {
+		t.Errorf("PackageName() = %q, %v, want %q, true", packageName, ok, "orders")
+	}
+}
@@ -0,0 +1,105 @@
+package helpers
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// MatchPackagePattern reports whether a file belongs to a package matched by a go tool style pattern.
+// Patterns are relative to the scan root: "./internal/domain" matches that directory only,
+// "./internal/domain/..." matches it and every directory below, "./..." matches everything.
+//
+// Parameters:
+//   - rootPath: The scanned root the pattern is relative to
+//   - file: The file path to check
+//   - pattern: The package pattern
+//
+// Returns:
+//   - true if the file's directory matches the pattern, false otherwise
+func MatchPackagePattern(rootPath string, file string, pattern string) bool {
+	dir := filepath.ToSlash(filepath.Dir(RelativePath(rootPath, file)))
+	pattern = path.Clean(strings.TrimPrefix(filepath.ToSlash(pattern), "./"))
+
+	if prefix, ok := strings.CutSuffix(pattern, "..."); ok {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if prefix == "" || prefix == "." {
+			return true
+		}
+
+		return dir == prefix || strings.HasPrefix(dir, prefix+"/")
+	}
+
+	return dir == pattern
+}
+
+// MatchAnyPackagePattern reports whether a file matches at least one package pattern.
+//
+// Parameters:
+//   - rootPath: The scanned root the patterns are relative to
+//   - file: The file path to check
+//   - patterns: The package patterns, an empty list matches every file
+//
+// Returns:
+//   - true if no patterns are given or one of them matches, false otherwise
+func MatchAnyPackagePattern(rootPath string, file string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if MatchPackagePattern(rootPath, file, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchImportPattern reports whether an import path is matched by a go tool style pattern,
+// e.g. "example.com/shop/legacy/..." matches that package and every package below.
+//
+// Parameters:
+//   - importPath: The import path to check
+//   - pattern: The import path pattern
+//
+// Returns:
+//   - true if the import path matches the pattern, false otherwise
+func MatchImportPattern(importPath string, pattern string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "/..."); ok {
+		return importPath == prefix || strings.HasPrefix(importPath, prefix+"/")
+	}
+
+	return importPath == pattern
+}
+
+// ModuleImportPath returns the import path of the package in directory dir,
+// derived from the module path declared in the nearest go.mod file, see ReadModulePath.
+//
+// Parameters:
+//   - dir: The package directory
+//
+// Returns:
+//   - The import path and true if a go.mod file was found, empty string and false otherwise
+func ModuleImportPath(dir string) (string, bool) {
+	moduleDir, modulePath, err := findModule(dir)
+	if err != nil {
+		return "", false
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+
+	rel, err := filepath.Rel(moduleDir, absDir)
+	if err != nil {
+		return "", false
+	}
+
+	if rel == "." {
+		return modulePath, true
+	}
+
+	return path.Join(modulePath, filepath.ToSlash(rel)), true
+}
@@ -0,0 +1,117 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// zeroDeclaration is a variable declared with the zero value of a SomeObject, e.g. `var loc Location`.
+type zeroDeclaration struct {
+	typeKey string
+	pos     token.Pos
+}
+
+// FindPiecemealConstructions scans for SomeObjects declared as zero values and then filled
+// field by field in the same block, e.g. `var loc Location; loc.x = 1`, bypassing the constructor.
+// Declarations inside constructors are not reported.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//   - constructors: A map of constructor information for checking scope
+//
+// Returns:
+//   - A map of violation messages to piecemeal construction violations
+//   - An error if the scan fails, nil otherwise
+func FindPiecemealConstructions(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			block, ok := n.(*ast.BlockStmt)
+			if !ok {
+				return true
+			}
+
+			declared := make(map[string]*zeroDeclaration)
+
+			for _, stmt := range block.List {
+				switch s := stmt.(type) {
+				case *ast.DeclStmt:
+					genDecl, ok := s.Decl.(*ast.GenDecl)
+					if !ok || genDecl.Tok != token.VAR {
+						continue
+					}
+
+					for _, spec := range genDecl.Specs {
+						valueSpec, ok := spec.(*ast.ValueSpec)
+						if !ok || valueSpec.Type == nil || len(valueSpec.Values) != 0 {
+							continue
+						}
+
+						typeKey, ok := resolveTypeKey(file, currentPackage, packages, valueSpec.Type)
+						if !ok || !typeDeclarations[typeKey] {
+							continue
+						}
+
+						for _, name := range valueSpec.Names {
+							declared[name.Name] = &zeroDeclaration{typeKey: typeKey, pos: valueSpec.Pos()}
+						}
+					}
+				case *ast.AssignStmt:
+					if s.Tok == token.DEFINE {
+						continue
+					}
+
+					for _, lhs := range s.Lhs {
+						selector, ok := lhs.(*ast.SelectorExpr)
+						if !ok {
+							continue
+						}
+
+						ident, ok := selector.X.(*ast.Ident)
+						if !ok {
+							continue
+						}
+
+						declaration, ok := declared[ident.Name]
+						if !ok {
+							continue
+						}
+
+						// Report every declaration once, at the declaration itself
+						delete(declared, ident.Name)
+
+						position := fileSet.Position(declaration.pos)
+						if IsInsideConstructor(path, position.Line, declaration.typeKey, constructors) {
+							continue
+						}
+
+						message := fmt.Sprintf("VIOLATION: Piecemeal construction of %s %s %s assigned field by field at %s:%d (%s)", markerName, declaration.typeKey, ident.Name, path, position.Line, checkName)
+						violations[message] = NewViolationAt(checkName, declaration.typeKey, path, position.Line, position.Column, message)
+					}
+				}
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
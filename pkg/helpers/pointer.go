@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindPointerMarkers scans for structs embedding the SomeObject marker as a pointer,
+// e.g. `_ *valueobject.ValueObject`, which is a misconfiguration of the marker.
+// It parses rootPath for this scan only, see FindPointerMarkersInWalk to share one parse across scans.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - fullPackage: The full package path of the marker
+//   - markerField: The name of the marker field, usually "_"
+//   - declaredName: The marker type name, also used in violation messages
+//
+// Returns:
+//   - A map of violation messages to pointer marker violations
+//   - An error if the scan fails, nil otherwise
+func FindPointerMarkers(rootPath string, checkName string, fullPackage string, markerField string, declaredName string) (map[string]*Violation, error) {
+	result, err := FindPointerMarkersInWalk(NewOptions().Walker(rootPath), checkName, fullPackage, markerField, declaredName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return result, nil
+}
+
+// FindPointerMarkersInWalk scans for structs embedding the SomeObject marker as a pointer,
+// e.g. `_ *valueobject.ValueObject`, which is a misconfiguration of the marker.
+// It walks the files of walk, so the scan can share one parse with other scans, see Options.Walker.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - fullPackage: The full package path of the marker
+//   - markerField: The name of the marker field, usually "_"
+//   - declaredName: The marker type name, also used in violation messages
+//
+// Returns:
+//   - A map of violation messages to pointer marker violations
+//   - An error if the scan fails, nil otherwise
+func FindPointerMarkersInWalk(walk Walker, checkName string, fullPackage string, markerField string, declaredName string) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		pkgAliases := GetPackageAliases(file, fullPackage)
+		if len(pkgAliases) == 0 {
+			return
+		}
+
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok || structType.Fields == nil {
+				return true
+			}
+
+			for _, field := range structType.Fields.List {
+				isMarker, isPointer := IsSomeObjectMarkerField(field, pkgAliases, markerField, declaredName)
+				if !isMarker || !isPointer {
+					continue
+				}
+
+				typeKey := currentPackage + "." + typeSpec.Name.Name
+				position := fileSet.Position(field.Pos())
+
+				message := fmt.Sprintf("VIOLATION: %s marker of %s is embedded as a pointer at %s:%d (%s)", declaredName, typeKey, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,32 @@
+package helpers
+
+import (
+	"testing"
+)
+
+const valueObjectPackage = "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+
+func TestFindPointerMarkers(t *testing.T) {
+	violations, err := FindPointerMarkers("testdata/pointermarker", CheckPointerMarker, valueObjectPackage, "_", "ValueObject")
+	if err != nil {
+		t.Fatalf("FindPointerMarkers() error = %v", err)
+	}
+
+	if len(violations) != 1 {
+		t.Fatalf("FindPointerMarkers() found %d violations, want 1: %v", len(violations), violations)
+	}
+
+	for _, violation := range violations {
+		if violation.Check != CheckPointerMarker {
+			t.Errorf("violation check = %q, want %q", violation.Check, CheckPointerMarker)
+		}
+
+		if violation.TypeName != "money.Money" {
+			t.Errorf("violation type = %q, want %q", violation.TypeName, "money.Money")
+		}
+
+		if violation.Line != 9 {
+			t.Errorf("violation line = %d, want 9", violation.Line)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// PointerField describes a struct field holding a pointer to another SomeObject.
+//
+// Fields:
+//   - Holder: The type declaring the field in format "package.TypeName"
+//   - Name: The field name
+//   - Target: The pointed-to type in format "package.TypeName"
+type PointerField struct {
+	Holder string
+	Name   string
+	Target string
+}
+
+// FindPointerFields collects the fields of holder types that point to target types, e.g. `money *Money`.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - holderTypes: A map of type names whose fields are inspected
+//   - targetTypes: A map of type names the fields may point to
+//
+// Returns:
+//   - A map of field names to the pointer fields with that name
+//   - An error if the scan fails, nil otherwise
+func FindPointerFields(walk Walker, holderTypes map[string]bool, targetTypes map[string]bool) (map[string][]*PointerField, error) {
+	pointerFields := make(map[string][]*PointerField)
+
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			holder := currentPackage + "." + typeSpec.Name.Name
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok || structType.Fields == nil || !holderTypes[holder] {
+				return true
+			}
+
+			for _, field := range structType.Fields.List {
+				star, ok := ast.Unparen(field.Type).(*ast.StarExpr)
+				if !ok {
+					continue
+				}
+
+				target, ok := resolveTypeKey(file, currentPackage, packages, star.X)
+				if !ok || !targetTypes[target] {
+					continue
+				}
+
+				names := field.Names
+				if len(names) == 0 {
+					names = []*ast.Ident{ast.NewIdent(embeddedFieldName(star.X))}
+				}
+
+				for _, name := range names {
+					pointerFields[name.Name] = append(pointerFields[name.Name], &PointerField{
+						Holder: holder,
+						Name:   name.Name,
+						Target: target,
+					})
+				}
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return pointerFields, nil
+}
+
+// FindMutationsThroughPointerFields scans for assignments such as `entity.money.amount = x`
+// that change a field of a SomeObject reached through a pointer field of another type.
+// Receiver types are unknown without type checking, so selector chains are matched
+// heuristically by field names: the intermediate name must be a known pointer field and
+// the assigned name must be a field of the pointed-to type.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The marker name of the mutated SomeObject, used in violation messages
+//   - holderName: The marker name of the types holding the pointers, used in violation messages
+//   - pointerFields: A map of field names to pointer fields, see FindPointerFields
+//   - targetFields: A map of pointed-to type names to their field names
+//
+// Returns:
+//   - A map of violation messages to mutation violations
+//   - An error if the scan fails, nil otherwise
+func FindMutationsThroughPointerFields(walk Walker, checkName string, markerName string, holderName string, pointerFields map[string][]*PointerField, targetFields map[string][]string) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		ast.Inspect(file, func(n ast.Node) bool {
+			var targets []ast.Expr
+
+			switch stmt := n.(type) {
+			case *ast.AssignStmt:
+				if stmt.Tok != token.DEFINE {
+					targets = stmt.Lhs
+				}
+			case *ast.IncDecStmt:
+				targets = []ast.Expr{stmt.X}
+			}
+
+			for _, target := range targets {
+				pointerField, fieldName, ok := matchPointerFieldMutation(target, pointerFields, targetFields)
+				if !ok {
+					continue
+				}
+
+				position := fileSet.Position(target.Pos())
+
+				message := fmt.Sprintf("VIOLATION: %s %s mutated through pointer field %s of %s %s (field %s) at %s:%d (%s)", markerName, pointerField.Target, pointerField.Name, holderName, holdersOf(pointerFields[pointerField.Name], pointerField.Target), fieldName, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, pointerField.Target, path, position.Line, position.Column, message)
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
+
+// FindPointerFieldReplacements scans methods of holder types for assignments such as `e.money = &Money{...}`
+// replacing the SomeObject a pointer field of the receiver points to, which swaps the value
+// under every other holder of the old pointer instead of changing it in one place.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The marker name of the replaced SomeObject, used in violation messages
+//   - holderName: The marker name of the types holding the pointers, used in violation messages
+//   - pointerFields: A map of field names to pointer fields, see FindPointerFields
+//
+// Returns:
+//   - A map of violation messages to replacement violations
+//   - An error if the scan fails, nil otherwise
+func FindPointerFieldReplacements(walk Walker, checkName string, markerName string, holderName string, pointerFields map[string][]*PointerField) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil || funcDecl.Recv == nil || len(funcDecl.Recv.List[0].Names) == 0 {
+				continue
+			}
+
+			holder, _, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			if !ok {
+				continue
+			}
+
+			receiver := funcDecl.Recv.List[0].Names[0].Obj
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				stmt, ok := n.(*ast.AssignStmt)
+				if !ok || stmt.Tok == token.DEFINE {
+					return true
+				}
+
+				for _, target := range stmt.Lhs {
+					selector, ok := ast.Unparen(target).(*ast.SelectorExpr)
+					if !ok {
+						continue
+					}
+
+					ident, ok := ast.Unparen(selector.X).(*ast.Ident)
+					if !ok || ident.Obj == nil || ident.Obj != receiver {
+						continue
+					}
+
+					for _, pointerField := range pointerFields[selector.Sel.Name] {
+						if pointerField.Holder != holder {
+							continue
+						}
+
+						position := fileSet.Position(target.Pos())
+
+						message := fmt.Sprintf("VIOLATION: %s %s replaced through pointer field %s of %s %s at %s:%d (%s)", markerName, pointerField.Target, pointerField.Name, holderName, holder, path, position.Line, checkName)
+						violations[message] = NewViolationAt(checkName, pointerField.Target, path, position.Line, position.Column, message)
+					}
+				}
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
+
+// matchPointerFieldMutation walks an assignment target's selector chain looking for
+// `<pointer field>.<field of the pointed-to type>`.
+func matchPointerFieldMutation(target ast.Expr, pointerFields map[string][]*PointerField, targetFields map[string][]string) (*PointerField, string, bool) {
+	expr := ast.Unparen(target)
+
+	for {
+		selector, ok := expr.(*ast.SelectorExpr)
+		if !ok {
+			return nil, "", false
+		}
+
+		if inner, ok := ast.Unparen(selector.X).(*ast.SelectorExpr); ok {
+			for _, pointerField := range pointerFields[inner.Sel.Name] {
+				if isOneOf(selector.Sel.Name, targetFields[pointerField.Target]) {
+					return pointerField, selector.Sel.Name, true
+				}
+			}
+		}
+
+		expr = ast.Unparen(selector.X)
+	}
+}
+
+// holdersOf lists the holder types of same-named pointer fields pointing to target.
+func holdersOf(pointerFields []*PointerField, target string) string {
+	var holders []string
+
+	for _, pointerField := range pointerFields {
+		if pointerField.Target == target {
+			holders = append(holders, pointerField.Holder)
+		}
+	}
+
+	sort.Strings(holders)
+
+	return strings.Join(holders, ", ")
+}
@@ -0,0 +1,184 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindUnhandledTypes reports SomeObject types that no handler references, e.g. aggregate roots
+// no command handler loads or saves, which leaves them unreachable from the write model.
+// A handler references a type when its signature or body names the type or calls one of its constructors,
+// or when a field of the handler's receiver has a method, or interface method, whose signature names the type,
+// e.g. `func (h *PlaceOrderHandler) Handle(ctx context.Context, cmd PlaceOrder) error` holding `orders OrderRepository`
+// with `Load(id OrderID) (*Order, error)`.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - handledName: The marker name of the handled types used in violation messages
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//   - handlers: A map of handled type names to their handlers, see FindHandlers
+//   - constructors: A map of constructor information, see FindConstructors
+//
+// Returns:
+//   - A map of violation messages to unhandled type violations
+//   - An error if the scan fails, nil otherwise
+func FindUnhandledTypes(walk Walker, checkName string, markerName string, handledName string, locations map[string]*TypeLocation, handlers map[string][]*HandlerInfo, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	// file:line of every handler
+	handlerPositions := make(map[string]bool)
+
+	for _, typeHandlers := range handlers {
+		for _, handler := range typeHandlers {
+			handlerPositions[fmt.Sprintf("%s:%d", handler.File, handler.Line)] = true
+		}
+	}
+
+	// package-qualified constructor name -> constructed type
+	constructorTypes := make(map[string]string, len(constructors))
+
+	for _, constructor := range constructors {
+		typePackage, _ := SplitTypeKey(constructor.TypeName)
+		constructorTypes[typePackage+"."+constructor.Name] = constructor.TypeName
+	}
+
+	referenced := make(map[string]bool)
+	receivers := make(map[string]bool)
+
+	collect := func(file *ast.File, currentPackage string, node ast.Node) {
+		ast.Inspect(node, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				if function, ok := resolveTypeKey(file, currentPackage, packages, node.Fun); ok && constructorTypes[function] != "" {
+					referenced[constructorTypes[function]] = true
+				}
+			case *ast.Ident, *ast.SelectorExpr:
+				if typeKey, ok := resolveTypeKey(file, currentPackage, packages, node.(ast.Expr)); ok {
+					referenced[typeKey] = true
+				}
+
+				// The package and type names of a selector are no types of the current package
+				return false
+			}
+
+			return true
+		})
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || !handlerPositions[fmt.Sprintf("%s:%d", path, fileSet.Position(funcDecl.Pos()).Line)] {
+				continue
+			}
+
+			collect(file, currentPackage, funcDecl)
+
+			if receiver, _, ok := ReceiverTypeKey(currentPackage, funcDecl); ok {
+				receivers[receiver] = true
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	dependencies, err := findFieldTypes(walk, packages, receivers)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.TypeSpec:
+				if iface, ok := node.Type.(*ast.InterfaceType); ok && dependencies[currentPackage+"."+node.Name.Name] {
+					collect(file, currentPackage, iface)
+				}
+			case *ast.FuncDecl:
+				if receiver, _, ok := ReceiverTypeKey(currentPackage, node); ok && dependencies[receiver] {
+					collect(file, currentPackage, node.Type)
+				}
+
+				return false
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	violations := make(map[string]*Violation)
+
+	for typeKey, location := range locations {
+		if referenced[typeKey] {
+			continue
+		}
+
+		message := fmt.Sprintf("VIOLATION: %s %s is not referenced by any %s handler at %s:%d (%s)", markerName, typeKey, handledName, location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations, nil
+}
+
+// findFieldTypes collects the types of the fields of the given struct types, pointers dereferenced.
+func findFieldTypes(walk Walker, packages PackageIndex, typeDeclarations map[string]bool) (map[string]bool, error) {
+	fieldTypes := make(map[string]bool)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || !typeDeclarations[currentPackage+"."+typeSpec.Name.Name] {
+					continue
+				}
+
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+
+				for _, field := range structType.Fields.List {
+					fieldType := ast.Unparen(field.Type)
+					if star, ok := fieldType.(*ast.StarExpr); ok {
+						fieldType = star.X
+					}
+
+					if typeKey, ok := resolveTypeKey(file, currentPackage, packages, fieldType); ok {
+						fieldTypes[typeKey] = true
+					}
+				}
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return fieldTypes, nil
+}
@@ -0,0 +1,80 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindUnreferencedTypes reports SomeObject types that no method of the referrer types accepts or returns,
+// e.g. aggregate roots without a repository loading or saving them.
+// Any mention of a type in a method signature counts, including pointers, slices and maps of it.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - referrerName: The marker name of the referrer types used in violation messages
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//   - referrers: A map of the referrer type names
+//
+// Returns:
+//   - A map of violation messages to unreferenced type violations
+//   - An error if the scan fails, nil otherwise
+func FindUnreferencedTypes(walk Walker, checkName string, markerName string, referrerName string, locations map[string]*TypeLocation, referrers map[string]bool) (map[string]*Violation, error) {
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	referenced := make(map[string]bool)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+
+			receiver, _, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			if !ok || !referrers[receiver] {
+				continue
+			}
+
+			ast.Inspect(funcDecl.Type, func(n ast.Node) bool {
+				switch node := n.(type) {
+				case *ast.Ident, *ast.SelectorExpr:
+					if typeKey, ok := resolveTypeKey(file, currentPackage, packages, node.(ast.Expr)); ok {
+						referenced[typeKey] = true
+					}
+
+					// The package and type names of a selector are no types of the current package
+					return false
+				}
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	violations := make(map[string]*Violation)
+
+	for typeKey, location := range locations {
+		if referenced[typeKey] {
+			continue
+		}
+
+		message := fmt.Sprintf("VIOLATION: %s %s is not accepted or returned by any %s at %s:%d (%s)", markerName, typeKey, referrerName, location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,101 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// reflectConstructors lists the reflect functions that create values bypassing constructors.
+var reflectConstructors = map[string]bool{
+	"New":  true,
+	"Zero": true,
+}
+
+// FindReflectiveConstructions scans for reflect.New and reflect.Zero calls whose type argument
+// mentions a SomeObject, either as a composite literal, e.g. reflect.TypeOf(Location{}),
+// or as a typed nil pointer, e.g. reflect.TypeOf((*Location)(nil)).Elem().
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to reflective construction violations
+//   - An error if the scan fails, nil otherwise
+func FindReflectiveConstructions(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		reflectAliases := GetPackageAliases(file, "reflect")
+		if len(reflectAliases) == 0 {
+			return
+		}
+
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+			if !ok || len(callExpr.Args) != 1 {
+				return true
+			}
+
+			selector, ok := callExpr.Fun.(*ast.SelectorExpr)
+			if !ok || !reflectConstructors[selector.Sel.Name] {
+				return true
+			}
+
+			ident, ok := selector.X.(*ast.Ident)
+			if !ok || !isOneOf(ident.Name, reflectAliases) {
+				return true
+			}
+
+			ast.Inspect(callExpr.Args[0], func(inner ast.Node) bool {
+				var typeExpr ast.Expr
+
+				switch e := inner.(type) {
+				case *ast.CompositeLit:
+					typeExpr = e.Type
+				case *ast.CallExpr:
+					// Typed nil pointer (*Location)(nil)
+					if star, ok := ast.Unparen(e.Fun).(*ast.StarExpr); ok {
+						typeExpr = star.X
+					}
+				}
+
+				if typeExpr == nil {
+					return true
+				}
+
+				typeKey, ok := resolveTypeKey(file, currentPackage, packages, typeExpr)
+				if !ok || !typeDeclarations[typeKey] {
+					return true
+				}
+
+				position := fileSet.Position(callExpr.Pos())
+
+				message := fmt.Sprintf("VIOLATION: Reflective construction of %s %s via reflect.%s at %s:%d (%s)", markerName, typeKey, selector.Sel.Name, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+
+				return false
+			})
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
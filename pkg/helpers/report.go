@@ -0,0 +1,478 @@
+package helpers
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ReportSchemaVersion is the version of the Report shape, following semantic versioning:
+// the major version changes when a field is removed, renamed or changes meaning,
+// the minor version changes when a field is added.
+const ReportSchemaVersion = "2.6.0"
+
+// Report contains the results of SomeObject validation analysis.
+//
+// Fields:
+//   - SchemaVersion: The version of the report shape, see ReportSchemaVersion
+//   - Types: Map of discovered type names, qualified with their import path, to their validation status
+//   - Constructors: Map of constructor function names to detailed constructor information
+//   - Files: Map of qualified type names to the sorted files declaring the type, its constructors and methods
+//   - Violations: Map of violation messages to the violation details
+//   - Counts: Number of violations per severity
+//   - MinSeverity: The lowest severity that makes the report fail
+//   - Metrics: Timings of the run when enabled with WithMetrics, nil otherwise
+//   - ScanErrors: The files and directories that could not be read, so the scan did not cover them
+//   - StrictScan: Whether scan errors make the report fail, see WithStrictScan
+type Report struct {
+	SchemaVersion string                      `json:"schemaVersion"`
+	Types         map[string]bool             `json:"types"`
+	Constructors  map[string]*ConstructorInfo `json:"constructors"`
+	Files         map[string][]string         `json:"files"`
+	Violations    map[string]*Violation       `json:"violations"`
+	Counts        map[Severity]int            `json:"counts"`
+	MinSeverity   Severity                    `json:"minSeverity"`
+	Metrics       *Metrics                    `json:"metrics,omitempty"`
+	ScanErrors    []ScanError                 `json:"scanErrors,omitempty"`
+	StrictScan    bool                        `json:"strictScan,omitempty"`
+}
+
+// NewReport assembles a report and counts its violations per severity.
+// Violations of disabled checks, of filtered out types, in ignored files,
+// outside the selected packages or added lines, accepted by the baseline or excluded by the generated file rules
+// are dropped, configured check severity overrides, WithWarningsAsErrors and package severity overrides are applied,
+// suggestions are attached when enabled with WithSuggestions and file paths are made relative to options.RelativeTo
+// and use forward slashes on every platform.
+// Type keys are qualified with the import path of the declaring package when a go.mod file is found,
+// e.g. "github.com/acme/shop/location.Location", use SplitTypeKey to take them apart.
+//
+// Parameters:
+//   - types: Discovered type names
+//   - constructors: Discovered constructors
+//   - violations: Found violations
+//   - options: Evaluation options
+//
+// Returns:
+//   - The assembled report
+func NewReport(types map[string]bool, constructors map[string]*ConstructorInfo, violations map[string]*Violation, options *Options) *Report {
+	declaring := options.declaringDirs(types)
+
+	report := &Report{
+		SchemaVersion: ReportSchemaVersion,
+		Types:         options.qualifiedTypes(types, declaring),
+		Constructors:  constructors,
+		Files:         options.typeFiles(types, constructors, declaring),
+		Violations:    violations,
+		Counts:        make(map[Severity]int),
+		MinSeverity:   options.MinSeverity,
+		Metrics:       options.Metrics,
+		StrictScan:    options.StrictScan,
+	}
+
+	for _, scanError := range options.scanErrors {
+		scanError.Path = options.reportPath(scanError.Path)
+		report.ScanErrors = append(report.ScanErrors, scanError)
+	}
+
+	for key, violation := range violations {
+		if options.Suggestions {
+			violation.Suggestion = Suggest(violation, constructors)
+		}
+
+		violation.TypeName = options.qualifyDeclaredTypeKey(declaring, violation.File, violation.TypeName)
+
+		if !options.IsCheckEnabled(violation.Check) || !options.IsTypeIncluded(violation.TypeName) {
+			delete(violations, key)
+			continue
+		}
+
+		if IsIgnoredPath(options.RootPath, violation.File, options.Ignore) || !MatchAnyPackagePattern(options.RootPath, violation.File, options.Packages) || options.IsBaselined(violation) {
+			delete(violations, key)
+			continue
+		}
+
+		if !options.IsReportedInGeneratedFile(violation) || !options.IsOnAddedLine(violation) {
+			delete(violations, key)
+			continue
+		}
+
+		if severity, ok := options.Severities[violation.Check]; ok {
+			violation.Severity = severity
+		}
+
+		if options.WarningsAsErrors && violation.Severity == SeverityWarning {
+			violation.Severity = SeverityError
+		}
+
+		if !options.applyPackageOverride(violation) {
+			delete(violations, key)
+			continue
+		}
+
+		report.Counts[violation.Severity]++
+	}
+
+	for _, constructor := range constructors {
+		constructor.TypeName = options.qualifyDeclaredTypeKey(declaring, constructor.File, constructor.TypeName)
+		constructor.Package, _ = SplitTypeKey(constructor.TypeName)
+	}
+
+	report.Violations = reportViolations(report.Violations, options.reportPath)
+	report.Constructors = reportConstructors(report.Constructors, options.reportPath)
+
+	return report
+}
+
+// RelativePath renders path relative to base, returning path unchanged if that is impossible.
+//
+// Parameters:
+//   - base: The base path
+//   - path: The path to render
+//
+// Returns:
+//   - The relative path
+func RelativePath(base string, path string) string {
+	absBase, err := filepath.Abs(base)
+	if err != nil {
+		return path
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+
+	rel, err := filepath.Rel(absBase, absPath)
+	if err != nil {
+		return path
+	}
+
+	return rel
+}
+
+// reportPath renders a path as reported: relative to RelativeTo when set
+// and with forward slashes on every platform, so reports compare equal across operating systems.
+func (o *Options) reportPath(path string) string {
+	if o.RelativeTo != "" {
+		path = RelativePath(o.RelativeTo, path)
+	}
+
+	return filepath.ToSlash(path)
+}
+
+// reportViolations rewrites violation paths and messages with rewrite.
+func reportViolations(violations map[string]*Violation, rewrite func(path string) string) map[string]*Violation {
+	rewritten := make(map[string]*Violation, len(violations))
+
+	for _, violation := range violations {
+		path := rewrite(violation.File)
+
+		violation.Message = strings.Replace(violation.Message, violation.File+":", path+":", 1)
+		violation.File = path
+
+		rewritten[violation.Message] = violation
+	}
+
+	return rewritten
+}
+
+// reportConstructors rewrites constructor paths and keys with rewrite.
+func reportConstructors(constructors map[string]*ConstructorInfo, rewrite func(path string) string) map[string]*ConstructorInfo {
+	rewritten := make(map[string]*ConstructorInfo, len(constructors))
+
+	for key, constructor := range constructors {
+		path := rewrite(constructor.File)
+
+		rewritten[path+strings.TrimPrefix(key, constructor.File)] = constructor
+		constructor.File = path
+	}
+
+	return rewritten
+}
+
+// TypesWithMultipleConstructors groups the constructors by the type they build and keeps the types
+// with more than one, which may point at inconsistent construction paths worth auditing.
+//
+// Returns:
+//   - A map of type names to the sorted keys of their constructors in Constructors
+func (r *Report) TypesWithMultipleConstructors() map[string][]string {
+	byType := make(map[string][]string)
+
+	for key, constructor := range r.Constructors {
+		byType[constructor.TypeName] = append(byType[constructor.TypeName], key)
+	}
+
+	for typeName, keys := range byType {
+		if len(keys) < 2 {
+			delete(byType, typeName)
+			continue
+		}
+
+		sort.Strings(keys)
+	}
+
+	return byType
+}
+
+// SortedConstructors returns the constructors ordered by file, then by start line.
+//
+// Returns:
+//   - Copies of the constructor information in source order
+func (r *Report) SortedConstructors() []ConstructorInfo {
+	constructors := make([]ConstructorInfo, 0, len(r.Constructors))
+
+	for _, constructor := range r.Constructors {
+		constructors = append(constructors, *constructor)
+	}
+
+	sort.Slice(constructors, func(i, j int) bool {
+		if constructors[i].File != constructors[j].File {
+			return constructors[i].File < constructors[j].File
+		}
+
+		if constructors[i].StartLine != constructors[j].StartLine {
+			return constructors[i].StartLine < constructors[j].StartLine
+		}
+
+		return constructors[i].Name < constructors[j].Name
+	})
+
+	return constructors
+}
+
+// FilesForType returns the files a type's logic lives in: the file declaring it
+// and the files declaring its constructors and methods.
+//
+// Parameters:
+//   - typeKey: The type name in format "import/path.TypeName" or "package.TypeName"
+//
+// Returns:
+//   - The sorted file paths, nil for unknown types
+func (r *Report) FilesForType(typeKey string) []string {
+	if files, ok := r.Files[typeKey]; ok {
+		return files
+	}
+
+	for qualifiedKey, files := range r.Files {
+		if shortTypeKey(qualifiedKey) == typeKey {
+			return files
+		}
+	}
+
+	return nil
+}
+
+// ViolationsByFile groups the violations by the file they were found in,
+// the shape editor integrations push diagnostics for open files in.
+//
+// Returns:
+//   - A map of file paths to copies of their violations, sorted by line and message
+func (r *Report) ViolationsByFile() map[string][]Violation {
+	byFile := make(map[string][]Violation)
+
+	for _, violation := range r.Violations {
+		byFile[violation.File] = append(byFile[violation.File], *violation)
+	}
+
+	for _, violations := range byFile {
+		sort.Slice(violations, func(i, j int) bool {
+			if violations[i].Line != violations[j].Line {
+				return violations[i].Line < violations[j].Line
+			}
+
+			return violations[i].Message < violations[j].Message
+		})
+	}
+
+	return byFile
+}
+
+// SummaryLine returns a one-line status of the report, such as
+// "3 violations across 2 files (1 error, 2 warnings)", for consoles where the full list is too long.
+//
+// Returns:
+//   - The summary line
+func (r *Report) SummaryLine() string {
+	files := make(map[string]bool)
+
+	for _, violation := range r.Violations {
+		files[violation.File] = true
+	}
+
+	summary := fmt.Sprintf("%s across %s", plural(len(r.Violations), "violation", "violations"), plural(len(files), "file", "files"))
+
+	var counts []string
+
+	for _, severity := range []Severity{SeverityError, SeverityWarning, SeverityInfo} {
+		pluralForm := severity.String() + "s"
+		if severity == SeverityInfo {
+			pluralForm = severity.String()
+		}
+
+		if count := r.Counts[severity]; count > 0 {
+			counts = append(counts, plural(count, severity.String(), pluralForm))
+		}
+	}
+
+	if len(counts) > 0 {
+		summary += " (" + strings.Join(counts, ", ") + ")"
+	}
+
+	return summary
+}
+
+// plural renders a count with the singular or plural noun.
+func plural(count int, singular string, pluralForm string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, singular)
+	}
+
+	return fmt.Sprintf("%d %s", count, pluralForm)
+}
+
+// Failures returns the number of violations at or above the report's minimum severity.
+func (r *Report) Failures() int {
+	failures := 0
+
+	for severity, count := range r.Counts {
+		if severity >= r.MinSeverity {
+			failures += count
+		}
+	}
+
+	return failures
+}
+
+// Failed reports whether the report contains any violation at or above its minimum severity,
+// or any scan error when StrictScan is set.
+func (r *Report) Failed() bool {
+	return r.Failures() > 0 || (r.StrictScan && len(r.ScanErrors) > 0)
+}
+
+// MergeViolations copies every violation from src into dst.
+//
+// Parameters:
+//   - dst: The violations map to extend
+//   - src: The violations to add
+func MergeViolations(dst map[string]*Violation, src map[string]*Violation) {
+	for key, violation := range src {
+		dst[key] = violation
+	}
+}
+
+// Merge adds the types, constructors, type files, violations and scan errors of other to the report.
+// Violations and scan errors already present in the report are counted once.
+//
+// Parameters:
+//   - other: The report to merge in
+func (r *Report) Merge(other *Report) {
+	if r.SchemaVersion == "" {
+		r.SchemaVersion = other.SchemaVersion
+	}
+
+	if r.Metrics == nil && other.Metrics != nil {
+		r.Metrics = &Metrics{}
+	}
+
+	r.Metrics.Add(other.Metrics)
+
+	if r.Types == nil {
+		r.Types = make(map[string]bool)
+	}
+
+	if r.Constructors == nil {
+		r.Constructors = make(map[string]*ConstructorInfo)
+	}
+
+	if r.Violations == nil {
+		r.Violations = make(map[string]*Violation)
+	}
+
+	if r.Counts == nil {
+		r.Counts = make(map[Severity]int)
+	}
+
+	for typeName, status := range other.Types {
+		r.Types[typeName] = status
+	}
+
+	for key, constructor := range other.Constructors {
+		r.Constructors[key] = constructor
+	}
+
+	if r.Files == nil {
+		r.Files = make(map[string][]string)
+	}
+
+	for typeName, files := range other.Files {
+		for _, file := range files {
+			if !slices.Contains(r.Files[typeName], file) {
+				r.Files[typeName] = append(r.Files[typeName], file)
+			}
+		}
+
+		sort.Strings(r.Files[typeName])
+	}
+
+	for key, violation := range other.Violations {
+		if _, ok := r.Violations[key]; !ok {
+			r.Counts[violation.Severity]++
+		}
+
+		r.Violations[key] = violation
+	}
+
+	r.StrictScan = r.StrictScan || other.StrictScan
+
+	for _, scanError := range other.ScanErrors {
+		if !slices.Contains(r.ScanErrors, scanError) {
+			r.ScanErrors = append(r.ScanErrors, scanError)
+		}
+	}
+}
+
+// MergeReports combines partial reports, e.g. of shards of a tree scanned on different CI runners, into one report.
+// Types, constructors, type files, violations and scan errors are unioned by key, so a violation reported
+// by several shards is counted once, see Report.Merge. The lowest minimum severity of the reports is kept,
+// so the merged report fails whenever any shard does.
+//
+// Constructors are matched and constructor scope is resolved within each scan, so every shard must be
+// a self-contained set of packages: a shard that does not include the constructors of its types reports
+// them as constructed outside their constructors, and merging cannot undo that. Shards should also render
+// paths relative to the same base, see WithRelativeTo, so violations of the same file share their key.
+//
+// Parameters:
+//   - reports: The partial reports, nil reports are skipped
+//
+// Returns:
+//   - The merged report, never nil
+func MergeReports(reports ...*Report) *Report {
+	merged := &Report{
+		SchemaVersion: ReportSchemaVersion,
+		Types:         make(map[string]bool),
+		Constructors:  make(map[string]*ConstructorInfo),
+		Files:         make(map[string][]string),
+		Violations:    make(map[string]*Violation),
+		Counts:        make(map[Severity]int),
+	}
+
+	first := true
+
+	for _, report := range reports {
+		if report == nil {
+			continue
+		}
+
+		if first || report.MinSeverity < merged.MinSeverity {
+			merged.MinSeverity = report.MinSeverity
+		}
+
+		first = false
+
+		merged.Merge(report)
+	}
+
+	return merged
+}
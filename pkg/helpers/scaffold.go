@@ -0,0 +1,197 @@
+package helpers
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// ConstructorStub is a generated constructor for a SomeObject type without one.
+//
+// Fields:
+//   - TypeName: The type name in format "package.TypeName"
+//   - File: The path of the file declaring the type as walked, which the stub belongs to
+//   - Source: The formatted source of the stub, e.g. "func NewMoney(amount int) (Money, error) {...}"
+type ConstructorStub struct {
+	TypeName string `json:"typeName"`
+	File     string `json:"file"`
+	Source   string `json:"source"`
+}
+
+// ScaffoldConstructors generates a `NewX(...) (X, error)` stub for every SomeObject type without a constructor,
+// see FindTypesWithoutConstructors, taking one parameter per data field and returning the filled value.
+// Generic types are skipped, their type parameters cannot be derived without guessing constraints,
+// so are types whose package already declares a function named like the stub, e.g. a NewX returning an interface.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - typeDeclarations: A map of SomeObjects type names
+//   - constructors: A map of constructor information, see FindConstructors
+//   - markerField: The name of the marker field, usually "_"
+//
+// Returns:
+//   - The stubs sorted by file and type name
+//   - An error if the scan fails, nil otherwise
+func ScaffoldConstructors(walk Walker, typeDeclarations map[string]bool, constructors map[string]*ConstructorInfo, markerField string) ([]*ConstructorStub, error) {
+	constructed := make(map[string]bool)
+
+	for _, constructor := range constructors {
+		if !constructor.Copy {
+			constructed[constructor.TypeName] = true
+		}
+	}
+
+	// package directory -> names of its functions
+	functions := make(map[string]map[string]bool)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		dir := filepath.Dir(path)
+
+		if functions[dir] == nil {
+			functions[dir] = make(map[string]bool)
+		}
+
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+				functions[dir][funcDecl.Name.Name] = true
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	var stubs []*ConstructorStub
+
+	var scaffoldErr error
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || typeSpec.TypeParams != nil {
+					continue
+				}
+
+				typeKey := currentPackage + "." + typeSpec.Name.Name
+
+				structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+				if !ok || !typeDeclarations[typeKey] || constructed[typeKey] || functions[filepath.Dir(path)]["New"+typeSpec.Name.Name] {
+					continue
+				}
+
+				source, err := constructorStub(fileSet, typeSpec.Name.Name, structType, markerField)
+				if err != nil {
+					scaffoldErr = ge.Pin(err, ge.Params{"type": typeKey})
+
+					return
+				}
+
+				stubs = append(stubs, &ConstructorStub{
+					TypeName: typeKey,
+					File:     path,
+					Source:   source,
+				})
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	if scaffoldErr != nil {
+		return nil, scaffoldErr
+	}
+
+	sort.Slice(stubs, func(i, j int) bool {
+		if stubs[i].File != stubs[j].File {
+			return stubs[i].File < stubs[j].File
+		}
+
+		return stubs[i].TypeName < stubs[j].TypeName
+	})
+
+	return stubs, nil
+}
+
+// constructorStub renders the formatted constructor of a struct type, one parameter per data field.
+func constructorStub(fileSet *token.FileSet, typeName string, structType *ast.StructType, markerField string) (string, error) {
+	var params, keys []string
+
+	for _, field := range structType.Fields.List {
+		var fieldType bytes.Buffer
+		if err := printer.Fprint(&fieldType, fileSet, field.Type); err != nil {
+			return "", ge.Pin(err)
+		}
+
+		for _, name := range fieldDisplayNames(field) {
+			if name == markerField || name == "_" {
+				continue
+			}
+
+			param := parameterName(name)
+
+			params = append(params, param+" "+fieldType.String())
+			keys = append(keys, name+": "+param+",")
+		}
+	}
+
+	var source strings.Builder
+
+	fmt.Fprintf(&source, "// New%[1]s creates a new %[1]s.\n", typeName)
+	fmt.Fprintf(&source, "func New%s(%s) (%s, error) {\n", typeName, strings.Join(params, ", "), typeName)
+	fmt.Fprintf(&source, "return %s{\n%s\n}, nil\n}\n", typeName, strings.Join(keys, "\n"))
+
+	formatted, err := format.Source([]byte(source.String()))
+	if err != nil {
+		return "", ge.Pin(err)
+	}
+
+	return string(formatted), nil
+}
+
+// parameterName derives a parameter name from a field name by lowering its leading capitals,
+// e.g. "amount" from "Amount", "id" from "ID" or "urlPath" from "URLPath", avoiding keywords such as "type".
+func parameterName(fieldName string) string {
+	runes := []rune(fieldName)
+
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+
+	// The last capital of an acronym followed by lower case letters starts the next word
+	if upper > 1 && upper < len(runes) {
+		upper--
+	}
+
+	for i := 0; i < upper; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	name := string(runes)
+
+	if token.IsKeyword(name) {
+		name += "Value"
+	}
+
+	return name
+}
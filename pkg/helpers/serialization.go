@@ -0,0 +1,157 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// jsonValueArguments maps the encoding/json functions and methods serializing a value to the index of its argument.
+var jsonValueArguments = map[string]int{
+	"Marshal":       0,
+	"MarshalIndent": 0,
+	"Unmarshal":     1,
+	"Encode":        0,
+	"Decode":        0,
+}
+
+// jsonStreamConstructors maps the encoding/json stream methods to the functions creating their receivers.
+var jsonStreamConstructors = map[string]string{
+	"Encode": "NewEncoder",
+	"Decode": "NewDecoder",
+}
+
+// jsonLocalName returns the name encoding/json is imported as, empty string if the file does not import it.
+func jsonLocalName(file *ast.File) string {
+	for _, imp := range file.Imports {
+		if strings.Trim(imp.Path.Value, `"`) != "encoding/json" {
+			continue
+		}
+
+		if imp.Name == nil {
+			return "json"
+		}
+
+		if imp.Name.Name != "_" && imp.Name.Name != "." {
+			return imp.Name.Name
+		}
+	}
+
+	return ""
+}
+
+// jsonCall returns the serialized argument and the function name of an encoding/json call such as
+// json.Marshal(v), json.Unmarshal(data, &v) or json.NewEncoder(w).Encode(v).
+func jsonCall(call *ast.CallExpr, jsonName string) (ast.Expr, string, bool) {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, "", false
+	}
+
+	index, ok := jsonValueArguments[selector.Sel.Name]
+	if !ok || index >= len(call.Args) {
+		return nil, "", false
+	}
+
+	switch x := selector.X.(type) {
+	case *ast.Ident:
+		if x.Name != jsonName || x.Obj != nil || jsonStreamConstructors[selector.Sel.Name] != "" {
+			return nil, "", false
+		}
+
+		return call.Args[index], "json." + selector.Sel.Name, true
+	case *ast.CallExpr:
+		constructor, ok := x.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return nil, "", false
+		}
+
+		ident, ok := constructor.X.(*ast.Ident)
+		if !ok || ident.Name != jsonName || ident.Obj != nil || constructor.Sel.Name != jsonStreamConstructors[selector.Sel.Name] {
+			return nil, "", false
+		}
+
+		return call.Args[index], "json." + constructor.Sel.Name + "()." + selector.Sel.Name, true
+	}
+
+	return nil, "", false
+}
+
+// FindBoundarySerializations scans the packages outside the domain layer for encoding/json calls serializing
+// SomeObjects directly, e.g. json.Marshal(order), coupling the domain representation to the wire format.
+// The serialized value is recognised when it is a composite literal, a call of a constructor, or a variable
+// or parameter declared with the type or assigned one of them, pointers included.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - rootPath: The scanned root the patterns are relative to
+//   - domainPatterns: The package patterns such as "./internal/domain/..." of the domain layer
+//   - typeDeclarations: A map of SomeObjects type names
+//   - constructors: A map of constructor information, see FindConstructors
+//
+// Returns:
+//   - A map of violation messages to boundary serialization violations
+//   - An error if the scan fails, nil otherwise
+func FindBoundarySerializations(walk Walker, checkName string, rootPath string, domainPatterns []string, typeDeclarations map[string]bool, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	constructorTypes := constructorTypeNames(constructors)
+
+	violations := make(map[string]*Violation)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		jsonName := jsonLocalName(file)
+		if jsonName == "" || MatchAnyPackagePattern(rootPath, path, domainPatterns) {
+			return
+		}
+
+		typer := newExpressionTyper(file, packages, typeDeclarations, constructorTypes)
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			variables := make(map[string]string)
+			typer.declareParameters(funcDecl, variables)
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				switch node := n.(type) {
+				case *ast.ValueSpec, *ast.AssignStmt:
+					typer.declare(node, variables)
+				case *ast.CallExpr:
+					argument, function, ok := jsonCall(node, jsonName)
+					if !ok {
+						return true
+					}
+
+					typeKey, ok := typer.typeOf(argument, variables)
+					if !ok {
+						return true
+					}
+
+					position := fileSet.Position(node.Pos())
+
+					message := fmt.Sprintf("VIOLATION: Domain type %s is serialized with %s outside the domain layer at %s:%d (%s)", typeKey, function, path, position.Line, checkName)
+					violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+				}
+
+				return true
+			})
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,66 @@
+package helpers
+
+import (
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// Severity describes how serious a violation is.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+)
+
+// String returns the lower-case name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText encodes the severity as its name, so JSON reports read "error" instead of 2.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a severity name.
+func (s *Severity) UnmarshalText(text []byte) error {
+	severity, err := ParseSeverity(string(text))
+	if err != nil {
+		return ge.Pin(err)
+	}
+
+	*s = severity
+
+	return nil
+}
+
+// ParseSeverity converts a severity name back into a Severity.
+//
+// Parameters:
+//   - name: One of "info", "warning" or "error"
+//
+// Returns:
+//   - The parsed severity
+//   - An error if the name is unknown, nil otherwise
+func ParseSeverity(name string) (Severity, error) {
+	switch name {
+	case "info":
+		return SeverityInfo, nil
+	case "warning":
+		return SeverityWarning, nil
+	case "error":
+		return SeverityError, nil
+	default:
+		return SeverityInfo, ge.Pin(&ge.UndefinedSwitchCaseError{Var: name})
+	}
+}
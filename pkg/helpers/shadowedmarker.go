@@ -0,0 +1,68 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindShadowedMarkerNames scans SomeObject structs for named data fields called like their marker,
+// e.g. a `ValueObject string` field of a Value Object, which reads like the marker at a glance.
+// Detection keys off the type of the marker field, so such a field never marks a struct by itself.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - markerField: The name of the marker field, usually "_"
+//   - typeDeclarations: A map of SomeObjects type names
+//   - shadowedNames: The field names to flag, usually the marker names of the stereotype
+//
+// Returns:
+//   - A map of violation messages to shadowed marker name violations
+//   - An error if the scan fails, nil otherwise
+func FindShadowedMarkerNames(walk Walker, checkName string, markerName string, markerField string, typeDeclarations map[string]bool, shadowedNames ...string) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			typeKey := currentPackage + "." + typeSpec.Name.Name
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok || structType.Fields == nil || !typeDeclarations[typeKey] {
+				return true
+			}
+
+			for _, field := range structType.Fields.List {
+				// Embedded fields are named after their type, an embedded marker is a marker misuse
+				for _, name := range field.Names {
+					if name.Name == markerField || !isOneOf(name.Name, shadowedNames) {
+						continue
+					}
+
+					position := fileSet.Position(name.Pos())
+
+					message := fmt.Sprintf("VIOLATION: Field %s of %s %s shadows the marker name at %s:%d (%s)", name.Name, markerName, typeKey, path, position.Line, checkName)
+					violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+				}
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
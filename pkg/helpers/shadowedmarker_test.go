@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"testing"
+)
+
+func TestFindShadowedMarkerNames(t *testing.T) {
+	const rootPath = "testdata/shadowedmarker"
+
+	walk := NewWalker(rootPath)
+
+	types, err := FindTypeDeclarationsInWalk(walk, NewOptions().TypeDeclaration(valueObjectPackage, "_", "ValueObject"))
+	if err != nil {
+		t.Fatalf("FindTypeDeclarationsInWalk() error = %v", err)
+	}
+
+	// A data field named like the marker never marks a struct by itself
+	for typeKey, want := range map[string]bool{"catalog.Label": false, "catalog.Tag": true, "catalog.Sku": true} {
+		if types[typeKey] != want {
+			t.Errorf("types[%q] = %v, want %v", typeKey, types[typeKey], want)
+		}
+	}
+
+	violations, err := FindShadowedMarkerNames(walk, CheckShadowedMarkerName, "ValueObject", "_", types, "ValueObject")
+	if err != nil {
+		t.Fatalf("FindShadowedMarkerNames() error = %v", err)
+	}
+
+	if len(violations) != 1 {
+		t.Fatalf("FindShadowedMarkerNames() found %d violations, want 1: %v", len(violations), violations)
+	}
+
+	for _, violation := range violations {
+		if violation.TypeName != "catalog.Tag" {
+			t.Errorf("violation type = %q, want %q", violation.TypeName, "catalog.Tag")
+		}
+
+		if violation.Severity != SeverityInfo {
+			t.Errorf("violation severity = %v, want %v", violation.Severity, SeverityInfo)
+		}
+	}
+}
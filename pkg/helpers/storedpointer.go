@@ -0,0 +1,107 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// ValueConstructedTypes returns the SomeObject types that have a constructor returning them by value.
+//
+// Parameters:
+//   - constructors: A map of constructor information, see FindConstructors
+//
+// Returns:
+//   - A map of type names constructed by value
+func ValueConstructedTypes(constructors map[string]*ConstructorInfo) map[string]bool {
+	types := make(map[string]bool)
+
+	for key, constructor := range constructors {
+		if constructor.Pointer {
+			continue
+		}
+
+		types[key[strings.LastIndex(key, ":")+1:]] = true
+	}
+
+	return types
+}
+
+// FindPointerStorage scans struct fields and variable declarations holding a pointer to a SomeObject,
+// e.g. `location *Location`, although the SomeObject is constructed by value.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names constructed by value, see ValueConstructedTypes
+//
+// Returns:
+//   - A map of violation messages to pointer storage violations
+//   - An error if the scan fails, nil otherwise
+func FindPointerStorage(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		report := func(kind string, names []string, typeExpr ast.Expr, pos token.Pos) {
+			star, ok := ast.Unparen(typeExpr).(*ast.StarExpr)
+			if !ok {
+				return
+			}
+
+			typeKey, ok := resolveTypeKey(file, currentPackage, packages, star.X)
+			if !ok || !typeDeclarations[typeKey] {
+				return
+			}
+
+			position := fileSet.Position(pos)
+
+			for _, name := range names {
+				message := fmt.Sprintf("VIOLATION: %s %s is constructed by value but stored as a pointer in %s %s at %s:%d (%s)", markerName, typeKey, kind, name, path, position.Line, checkName)
+				violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+			}
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.StructType:
+				if node.Fields == nil {
+					return true
+				}
+
+				for _, field := range node.Fields.List {
+					report("field", fieldDisplayNames(field), field.Type, field.Pos())
+				}
+			case *ast.ValueSpec:
+				if node.Type == nil {
+					return true
+				}
+
+				names := make([]string, 0, len(node.Names))
+				for _, name := range node.Names {
+					names = append(names, name.Name)
+				}
+
+				report("variable", names, node.Type, node.Pos())
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
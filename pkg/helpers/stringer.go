@@ -0,0 +1,69 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// IsStringMethod reports whether a method has the signature of fmt.Stringer's method, String() string.
+//
+// Parameters:
+//   - funcDecl: The method declaration
+//
+// Returns:
+//   - true if the method is named String, takes no parameters and returns a single string, false otherwise
+func IsStringMethod(funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Name.Name != "String" || funcDecl.Type.Params.NumFields() != 0 || funcDecl.Type.Results.NumFields() != 1 {
+		return false
+	}
+
+	ident, ok := ast.Unparen(funcDecl.Type.Results.List[0].Type).(*ast.Ident)
+
+	return ok && ident.Name == "string"
+}
+
+// FindPointerStringers scans for SomeObjects with a String() string method on a pointer receiver,
+// so only pointers satisfy fmt.Stringer and values of the type print without it.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to pointer receiver String violations
+//   - An error if the scan fails, nil otherwise
+func FindPointerStringers(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || !IsStringMethod(funcDecl) {
+				continue
+			}
+
+			typeKey, isPointer, ok := ReceiverTypeKey(currentPackage, funcDecl)
+			if !ok || !isPointer || !typeDeclarations[typeKey] {
+				continue
+			}
+
+			position := fileSet.Position(funcDecl.Pos())
+
+			message := fmt.Sprintf("VIOLATION: %s %s declares String on a pointer receiver at %s:%d (%s)", markerName, typeKey, path, position.Line, checkName)
+			violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
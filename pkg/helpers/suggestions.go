@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// checkSuggestions holds the suggested fix per check identifier.
+// %[1]s is the type name without its package, %[2]s the constructor calls to use instead.
+var checkSuggestions = map[string]string{
+	CheckZeroValueInitialization:        "use %[2]s instead of %[1]s{}",
+	CheckPiecemealConstruction:          "build the value with %[2]s instead of filling %[1]s field by field",
+	CheckPointerMarker:                  "declare the marker field of %[1]s by value, not as a pointer",
+	CheckMarkerNotLast:                  "move the marker field of %[1]s after its data fields",
+	CheckShadowedMarkerName:             "rename the field of %[1]s after the data it holds",
+	CheckReflectiveConstruction:         "call %[2]s instead of creating %[1]s through reflect",
+	CheckStereotypeInMain:               "move %[1]s into a domain package",
+	CheckLocalStereotypeDeclaration:     "declare %[1]s at package level",
+	CheckNoConstructor:                  "add a constructor such as %[2]s validating the fields of %[1]s",
+	CheckStereotypeEmbedding:            "replace the embedded type in %[1]s with a named field",
+	CheckStereotypeConcurrencyField:     "keep locks and channels in the service using %[1]s instead of in the type",
+	CheckStereotypeImplementsError:      "return a dedicated error type instead of making %[1]s an error, or list it in error-types",
+	CheckTrivialConstructor:             "validate or set the fields of %[1]s in its constructor",
+	CheckConstructorIgnoresError:        "return the error from the constructor of %[1]s instead of discarding it",
+	CheckEmptyValueObject:               "add the fields %[1]s represents or remove it",
+	CheckEmptyCommand:                   "add the data %[1]s carries to its handler or remove it",
+	CheckIncompleteConstruction:         "set every field of %[1]s in the returned composite literal",
+	CheckValueObjectFieldTags:           "map %[1]s to a separate DTO carrying the tags",
+	CheckNondeterministicValueObject:    "pass the time or random values into %[2]s as parameters",
+	CheckValueObjectPointerStringer:     "declare String on a value receiver of %[1]s",
+	CheckValueObjectNonValueField:       "compose %[1]s from Value Objects and primitives only",
+	CheckValueObjectGlobalMutation:      "keep the methods of %[1]s free of side effects and return the new state instead",
+	CheckValueObjectAddressTaken:        "pass %[1]s by value and return the derived value instead",
+	CheckValueObjectDeepEqual:           "compare %[1]s with its Equals method or ==",
+	CheckCrossContextCommand:            "handle %[1]s inside its own bounded context",
+	CheckCommandWithBehavior:            "move the logic of %[1]s into its handler or the domain",
+	CheckValueObjectStoredAsPointer:     "store %[1]s by value",
+	CheckEntityAsMapKey:                 "key the map by the identifier of %[1]s",
+	CheckAggregateInternalSetter:        "unexport the method and change %[1]s through its aggregate root",
+	CheckValueObjectMutationViaEntity:   "replace %[1]s with a new value from %[2]s instead of mutating it",
+	CheckValueObjectReplaced:            "hold %[1]s by value so the entity owns its copy",
+	CheckAnemicEntity:                   "move the behavior operating on %[1]s into its methods",
+	CheckAggregateExposesCollection:     "return a copy or an iterator instead of the internal collection of %[1]s",
+	CheckAggregateExternalMutation:      "move the change into a method of %[1]s that guards its invariants",
+	CheckCommandQueryConflict:           "split %[1]s into a separate command and query",
+	CheckAmbiguousConstructor:           "keep a single stereotype marker on %[1]s",
+	CheckUnusedExportedStereotype:       "unexport %[1]s or remove it",
+	CheckPossibleNilValueObject:         "hold the value object in %[1]s by value or check the field for nil first",
+	CheckUnmarkedDomainStruct:           "mark %[1]s with a stereotype marker field or move it out of the domain layer",
+	CheckDomainTypeSerializedAtBoundary: "map %[1]s to a DTO of the transport layer and serialize the DTO",
+	CheckUnexportedOnlyConstructor:      "export a constructor such as %[2]s for %[1]s",
+	CheckIncompleteEquals:               "compare every field of %[1]s in its equality method or document the excluded ones",
+	CheckConstructorOnlyInTests:         "move the constructor of %[1]s from the test file into the package",
+	CheckMarkerMisuse:                   "mark %[1]s with a struct field of the marker type named \"_\"",
+	CheckAggregateWithoutRepository:     "add a repository marked as Repository loading and saving %[1]s",
+	CheckAggregateRootUnreachable:       "load or save %[1]s from a command handler or remove it",
+	CheckLargeValueObject:               "split %[1]s into smaller value objects or model it as an entity",
+	CheckUnusedMarkerImport:             "remove the import or add the \"_\" marker field to the intended type",
+}
+
+// Suggest returns a short suggested fix for a violation, naming the constructors of its type where there are any.
+//
+// Parameters:
+//   - violation: The violation to suggest a fix for
+//   - constructors: A map of constructor names to their location information, see FindConstructors
+//
+// Returns:
+//   - The suggested fix, empty string for checks without a suggestion
+func Suggest(violation *Violation, constructors map[string]*ConstructorInfo) string {
+	suggestion, ok := checkSuggestions[violation.Check]
+	if !ok {
+		return ""
+	}
+
+	// File level checks have no type to mention
+	if !strings.Contains(suggestion, "%") {
+		return suggestion
+	}
+
+	_, typeName := SplitTypeKey(violation.TypeName)
+
+	var calls []string
+
+	for _, constructor := range constructors {
+		if constructor.TypeName == violation.TypeName && !constructor.Copy {
+			calls = append(calls, constructor.Name+"(...)")
+		}
+	}
+
+	sort.Strings(calls)
+
+	if len(calls) == 0 {
+		calls = append(calls, "New"+typeName+"(...)")
+	}
+
+	return fmt.Sprintf(suggestion, typeName, strings.Join(calls, " or "))
+}
@@ -0,0 +1,83 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindTaggedFields scans SomeObject structs for data fields carrying struct tags, e.g. `json:"amount"`,
+// which expose the internal representation instead of letting the type control its own marshaling.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - markerField: The name of the marker field, usually "_"
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to tagged field violations
+//   - An error if the scan fails, nil otherwise
+func FindTaggedFields(walk Walker, checkName string, markerName string, markerField string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			typeKey := currentPackage + "." + typeSpec.Name.Name
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok || structType.Fields == nil || !typeDeclarations[typeKey] {
+				return true
+			}
+
+			for _, field := range structType.Fields.List {
+				if field.Tag == nil {
+					continue
+				}
+
+				for _, name := range fieldDisplayNames(field) {
+					if name == markerField {
+						continue
+					}
+
+					position := fileSet.Position(field.Pos())
+
+					message := fmt.Sprintf("VIOLATION: Field %s of %s %s carries struct tag %s at %s:%d (%s)", name, markerName, typeKey, field.Tag.Value, path, position.Line, checkName)
+					violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+				}
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
+
+// fieldDisplayNames returns the names of a struct field, embedded fields are named after their type.
+func fieldDisplayNames(field *ast.Field) []string {
+	if len(field.Names) == 0 {
+		return []string{embeddedFieldName(field.Type)}
+	}
+
+	names := make([]string, 0, len(field.Names))
+	for _, name := range field.Names {
+		names = append(names, name.Name)
+	}
+
+	return names
+}
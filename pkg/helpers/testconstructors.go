@@ -0,0 +1,108 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindTestOnlyConstructors reports SomeObject types without a constructor whose constructors
+// are declared in _test.go files of their package, which the walkers skip, so a reader of
+// no-constructor and zero value violations can tell the type is constructed in tests.
+// The test files are parsed in a separate pass only for this check, their violations are never reported.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for test files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//   - constructors: A map of constructor information, see FindConstructors
+//
+// Returns:
+//   - A map of violation messages to test-only constructor violations
+//   - An error wrapping ErrScanFailed if the walk fails, nil otherwise
+func FindTestOnlyConstructors(rootPath string, checkName string, markerName string, locations map[string]*TypeLocation, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	constructed := make(map[string]bool)
+	for key, constructor := range constructors {
+		if constructor.Copy {
+			continue
+		}
+
+		constructed[key[strings.LastIndex(key, ":")+1:]] = true
+	}
+
+	// type -> "NewMoney (money_test.go:12)"
+	testConstructors := make(map[string][]string)
+	fileSet := token.NewFileSet()
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil && path == rootPath {
+			return err
+		}
+
+		if err != nil || !strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+
+		path = filepath.ToSlash(path)
+
+		file, err := parser.ParseFile(fileSet, path, src, 0)
+		if err != nil {
+			return nil
+		}
+
+		// Internal tests share the package, external ones are named after it with a "_test" suffix
+		currentPackage := strings.TrimSuffix(file.Name.Name, "_test")
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv != nil {
+				continue
+			}
+
+			typeKey, ok := resultTypeKey(currentPackage, funcDecl)
+			if !ok || !strings.HasPrefix(funcDecl.Name.Name, "New") && !isUnexportedConstructorName(funcDecl.Name.Name) {
+				continue
+			}
+
+			location := locations[typeKey]
+			if location == nil || constructed[typeKey] || filepath.Dir(location.File) != filepath.Dir(path) {
+				continue
+			}
+
+			line := fileSet.Position(funcDecl.Pos()).Line
+			testConstructors[typeKey] = append(testConstructors[typeKey], fmt.Sprintf("%s (%s:%d)", funcDecl.Name.Name, filepath.Base(path), line))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, ge.Pin(fmt.Errorf("%w: %w", ErrScanFailed, err))
+	}
+
+	for typeKey, constructorNames := range testConstructors {
+		location := locations[typeKey]
+
+		sort.Strings(constructorNames)
+
+		message := fmt.Sprintf("VIOLATION: %s %s is only constructed in test files by %s at %s:%d (%s)", markerName, typeKey, strings.Join(constructorNames, ", "), location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations, nil
+}
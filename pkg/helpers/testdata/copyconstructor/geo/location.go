@@ -0,0 +1,33 @@
+package geo
+
+import (
+	valueobject "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+)
+
+// Location is a Value Object with a copy constructor and a transform method.
+type Location struct {
+	x int
+	y int
+
+	_ valueobject.ValueObject
+}
+
+// NewLocation returns a new Location.
+func NewLocation(x, y int) Location {
+	return Location{x: x, y: y}
+}
+
+// NewLocationFrom returns a copy of other.
+func NewLocationFrom(other Location) Location {
+	return Location{x: other.x, y: other.y}
+}
+
+// Moved returns the Location moved by dx and dy.
+func (l Location) Moved(dx, dy int) Location {
+	return Location{x: l.x + dx, y: l.y + dy}
+}
+
+// Mirror passes an existing Location around, which is no bypass.
+func Mirror(location Location) Location {
+	return NewLocationFrom(location).Moved(-location.x*2, 0)
+}
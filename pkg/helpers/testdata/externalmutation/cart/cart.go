@@ -0,0 +1,29 @@
+package cart
+
+import (
+	"time"
+
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/aggregate"
+)
+
+// Cart is an aggregate root constructed through a pointer.
+type Cart struct {
+	_ aggregate.AggregateRoot
+
+	id      string
+	created time.Time
+	Items   []string
+}
+
+// NewCart initialises the Cart it returns, which is no external mutation.
+func NewCart(id string) *Cart {
+	c := &Cart{id: id}
+	c.created = time.Now()
+
+	return c
+}
+
+// Add mutates the Cart through its own method.
+func (c *Cart) Add(item string) {
+	c.Items = append(c.Items, item)
+}
@@ -0,0 +1,10 @@
+package checkout
+
+import (
+	"example.com/shop/cart"
+)
+
+// Clear bypasses the methods of the Cart.
+func Clear(c *cart.Cart) {
+	c.Items = nil
+}
@@ -0,0 +1,43 @@
+package billing
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Line is an invoice line.
+type Line struct {
+	Description string
+	Cents       int64
+}
+
+// Invoice is a list of lines.
+type Invoice struct {
+	Number string
+	Lines  []Line
+}
+
+// Total sums the lines of the invoice.
+func (i Invoice) Total() int64 {
+	var total int64
+	for _, line := range i.Lines {
+		total += line.Cents
+	}
+
+	return total
+}
+
+// Render formats the invoice as text.
+func (i Invoice) Render() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "Invoice %s\n", i.Number)
+
+	for _, line := range i.Lines {
+		fmt.Fprintf(&builder, "%-30s %10.2f\n", line.Description, float64(line.Cents)/100)
+	}
+
+	fmt.Fprintf(&builder, "%-30s %10.2f\n", "Total", float64(i.Total())/100)
+
+	return builder.String()
+}
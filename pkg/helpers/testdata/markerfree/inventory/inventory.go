@@ -0,0 +1,60 @@
+package inventory
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrOutOfStock is returned when a reservation exceeds the stock.
+var ErrOutOfStock = errors.New("out of stock")
+
+// Item is a stocked item.
+type Item struct {
+	SKU      string
+	Quantity int
+}
+
+// Stock holds items by SKU.
+type Stock struct {
+	items map[string]*Item
+}
+
+// NewStock returns an empty stock.
+func NewStock() *Stock {
+	return &Stock{items: make(map[string]*Item)}
+}
+
+// Add adds quantity of sku to the stock.
+func (s *Stock) Add(sku string, quantity int) {
+	item, ok := s.items[sku]
+	if !ok {
+		item = &Item{SKU: sku}
+		s.items[sku] = item
+	}
+
+	item.Quantity += quantity
+}
+
+// Reserve takes quantity of sku out of the stock.
+func (s *Stock) Reserve(sku string, quantity int) error {
+	item, ok := s.items[sku]
+	if !ok || item.Quantity < quantity {
+		return ErrOutOfStock
+	}
+
+	item.Quantity -= quantity
+
+	return nil
+}
+
+// SKUs returns the stocked SKUs in order.
+func (s *Stock) SKUs() []string {
+	skus := make([]string, 0, len(s.items))
+	for sku := range s.items {
+		skus = append(skus, sku)
+	}
+
+	sort.Strings(skus)
+
+	return skus
+}
@@ -0,0 +1,29 @@
+package shipping
+
+import (
+	"time"
+)
+
+// Parcel is a shipped parcel.
+type Parcel struct {
+	TrackingID string
+	Weight     float64
+	ShippedAt  time.Time
+}
+
+// Rate returns the shipping cost of a parcel in cents.
+func Rate(parcel Parcel) int64 {
+	switch {
+	case parcel.Weight <= 1:
+		return 499
+	case parcel.Weight <= 5:
+		return 999
+	default:
+		return 999 + int64((parcel.Weight-5)*150)
+	}
+}
+
+// Overdue reports whether a parcel has been on its way longer than days.
+func Overdue(parcel Parcel, now time.Time, days int) bool {
+	return now.Sub(parcel.ShippedAt) > time.Duration(days)*24*time.Hour
+}
@@ -0,0 +1,36 @@
+package orders
+
+import (
+	valueobject "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+)
+
+// Order is constructed through a pointer.
+type Order struct {
+	_ valueobject.ValueObject
+
+	id string
+}
+
+// NewOrder returns a pointer to a new Order.
+func NewOrder(id string) *Order {
+	return &Order{id: id}
+}
+
+// Money is constructed by value.
+type Money struct {
+	_ valueobject.ValueObject
+
+	cents int64
+}
+
+// NewMoney returns a new Money.
+func NewMoney(cents int64) Money {
+	return Money{cents: cents}
+}
+
+// Discount has no constructor.
+type Discount struct {
+	_ valueobject.ValueObject
+
+	percent int
+}
@@ -0,0 +1,19 @@
+package money
+
+import (
+	valueobject "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+)
+
+// Money embeds its marker as a pointer.
+type Money struct {
+	_ *valueobject.ValueObject
+
+	cents int64
+}
+
+// Currency embeds its marker correctly.
+type Currency struct {
+	_ valueobject.ValueObject
+
+	code string
+}
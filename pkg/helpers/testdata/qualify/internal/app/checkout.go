@@ -0,0 +1,10 @@
+package app
+
+import (
+	"example.com/fx/orders"
+)
+
+// Total bypasses the constructor of Money.
+func Total() orders.Money {
+	return orders.Money{}
+}
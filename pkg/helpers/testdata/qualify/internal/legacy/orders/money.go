@@ -0,0 +1,6 @@
+package orders
+
+// Money shares the short key of the Value Object without being one.
+type Money struct {
+	Cents int
+}
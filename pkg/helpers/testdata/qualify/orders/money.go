@@ -0,0 +1,17 @@
+package orders
+
+import (
+	valueobject "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+)
+
+// Money is a Value Object.
+type Money struct {
+	_ valueobject.ValueObject
+
+	cents int64
+}
+
+// NewMoney returns a new Money.
+func NewMoney(cents int64) (Money, error) {
+	return Money{cents: cents}, nil
+}
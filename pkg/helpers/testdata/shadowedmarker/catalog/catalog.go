@@ -0,0 +1,24 @@
+package catalog
+
+import (
+	valueobject "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+)
+
+// Label is no Value Object, its field only carries the marker name.
+type Label struct {
+	ValueObject string
+}
+
+// Tag is a Value Object whose data field shadows the marker name.
+type Tag struct {
+	_ valueobject.ValueObject
+
+	ValueObject string
+}
+
+// Sku is a Value Object with plainly named fields.
+type Sku struct {
+	_ valueobject.ValueObject
+
+	code string
+}
@@ -0,0 +1,6 @@
+package locked
+
+// Vault sits in the directory the test makes unreadable.
+type Vault struct {
+	secret string
+}
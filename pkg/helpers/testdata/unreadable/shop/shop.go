@@ -0,0 +1,6 @@
+package shop
+
+// Cart is readable.
+type Cart struct {
+	items []string
+}
@@ -0,0 +1,93 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// IsZeroValueExpr reports whether an expression is a literal zero value:
+// an empty composite literal, a pointer to one, nil, false, 0 or an empty string.
+//
+// Parameters:
+//   - expr: The expression to check
+//
+// Returns:
+//   - true if the expression is a zero value, false otherwise
+func IsZeroValueExpr(expr ast.Expr) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.CompositeLit:
+		return len(e.Elts) == 0
+	case *ast.UnaryExpr:
+		return e.Op == token.AND && IsZeroValueExpr(e.X)
+	case *ast.Ident:
+		return e.Name == "nil" || e.Name == "false"
+	case *ast.BasicLit:
+		return e.Value == "0" || e.Value == `""` || e.Value == "``"
+	default:
+		return false
+	}
+}
+
+// FindTrivialConstructors scans for constructors whose body is a single return of zero values,
+// so the constructor adds no validation at all.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - typeDeclarations: A map of SomeObjects type names
+//
+// Returns:
+//   - A map of violation messages to trivial constructor violations
+//   - An error if the scan fails, nil otherwise
+func FindTrivialConstructors(walk Walker, checkName string, markerName string, typeDeclarations map[string]bool) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil || len(funcDecl.Body.List) != 1 {
+				continue
+			}
+
+			typeKey, ok := ConstructedTypeKey(currentPackage, funcDecl)
+			if !ok || !typeDeclarations[typeKey] {
+				continue
+			}
+
+			returnStmt, ok := funcDecl.Body.List[0].(*ast.ReturnStmt)
+			if !ok || len(returnStmt.Results) == 0 {
+				continue
+			}
+
+			trivial := true
+
+			for _, result := range returnStmt.Results {
+				if !IsZeroValueExpr(result) {
+					trivial = false
+					break
+				}
+			}
+
+			if !trivial {
+				continue
+			}
+
+			position := fileSet.Position(funcDecl.Pos())
+
+			message := fmt.Sprintf("VIOLATION: Constructor %s of %s %s only returns a zero value at %s:%d (%s)", funcDecl.Name.Name, markerName, typeKey, path, position.Line, checkName)
+			violations[message] = NewViolationAt(checkName, typeKey, path, position.Line, position.Column, message)
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,55 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+// TypeCheckContext describes a discovered SomeObject type passed to custom type checks.
+//
+// Fields:
+//   - Path: The path of the file declaring the type
+//   - File: The parsed AST file declaring the type
+//   - TypeSpec: The type declaration
+//   - StructType: The struct type of the declaration
+//   - FileSet: The file set used to parse the file, for position lookups
+//   - Stereotype: The marker name of the type, e.g. "ValueObject"
+//   - TypeKey: The type name in format "package.TypeName"
+type TypeCheckContext struct {
+	Path       string
+	File       *ast.File
+	TypeSpec   *ast.TypeSpec
+	StructType *ast.StructType
+	FileSet    *token.FileSet
+	Stereotype string
+	TypeKey    string
+}
+
+// TypeCheckHook is a custom assertion run on every discovered SomeObject type, see WithTypeCheck.
+// Returned violations without a TypeName, File, Line or Message get those of the checked type.
+type TypeCheckHook func(ctx TypeCheckContext) []Violation
+
+// runTypeChecks runs custom type checks on a discovered type and adds their violations.
+func runTypeChecks(violations map[string]*Violation, typeChecks []TypeCheckHook, ctx TypeCheckContext) {
+	line := ctx.FileSet.Position(ctx.TypeSpec.Pos()).Line
+
+	for _, typeCheck := range typeChecks {
+		for _, violation := range typeCheck(ctx) {
+			if violation.TypeName == "" {
+				violation.TypeName = ctx.TypeKey
+			}
+
+			if violation.File == "" {
+				violation.File = ctx.Path
+				violation.Line = line
+			}
+
+			if violation.Message == "" {
+				violation.Message = fmt.Sprintf("VIOLATION: %s %s fails custom check at %s:%d (%s)", ctx.Stereotype, violation.TypeName, violation.File, violation.Line, violation.Check)
+			}
+
+			violations[violation.Message] = &violation
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package helpers
+
+import (
+	"go/ast"
+	"path/filepath"
+	"slices"
+	"sort"
+)
+
+// typeFiles collects the files of every discovered type: the file declaring it and the files declaring
+// its constructors and methods, keyed by the qualified type key and rendered as reported, see reportPath.
+// Files outside the packages declaring the type, see declaringDirs, belong to another type with the same short key.
+func (o *Options) typeFiles(types map[string]bool, constructors map[string]*ConstructorInfo, declaring map[string][]string) map[string][]string {
+	// qualified type key -> file set
+	files := make(map[string]map[string]bool)
+
+	add := func(file string, typeKey string) {
+		if !types[typeKey] {
+			return
+		}
+
+		if dirs := declaring[typeKey]; len(dirs) > 0 && !slices.Contains(dirs, filepath.Dir(file)) {
+			return
+		}
+
+		qualifiedKey := o.qualifyTypeKey(file, typeKey)
+		if !o.IsTypeIncluded(qualifiedKey) {
+			return
+		}
+
+		file = o.reportPath(file)
+
+		if files[qualifiedKey] == nil {
+			files[qualifiedKey] = make(map[string]bool)
+		}
+
+		files[qualifiedKey][file] = true
+	}
+
+	for _, source := range o.files {
+		currentPackage := source.File.Name.Name
+
+		ast.Inspect(source.File, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.TypeSpec:
+				add(source.Path, currentPackage+"."+node.Name.Name)
+			case *ast.FuncDecl:
+				if typeKey, _, ok := ReceiverTypeKey(currentPackage, node); ok {
+					add(source.Path, typeKey)
+				}
+
+				return false
+			case *ast.FuncLit:
+				return false
+			}
+
+			return true
+		})
+	}
+
+	for _, constructor := range constructors {
+		add(constructor.File, constructor.TypeName)
+	}
+
+	sorted := make(map[string][]string, len(files))
+
+	for typeKey, fileSet := range files {
+		for file := range fileSet {
+			sorted[typeKey] = append(sorted[typeKey], file)
+		}
+
+		sort.Strings(sorted[typeKey])
+	}
+
+	return sorted
+}
@@ -0,0 +1,116 @@
+package helpers
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/types"
+	"path/filepath"
+)
+
+// TypeCheck type-checks the parsed files package by package, tolerating errors,
+// so the returned information may be partial for packages that do not compile
+// or whose imports cannot be resolved.
+//
+// Parameters:
+//   - files: The parsed files, sharing one file set as produced by ParseGoFiles
+//
+// Returns:
+//   - A map of files to the type information recorded for their expressions
+func TypeCheck(files []*SourceFile) map[*ast.File]*types.Info {
+	infos := make(map[*ast.File]*types.Info)
+
+	if len(files) == 0 {
+		return infos
+	}
+
+	fileSet := files[0].FileSet
+	packages := make(map[string][]*ast.File)
+
+	var order []string
+
+	for _, file := range files {
+		key := filepath.Dir(file.Path) + ":" + file.File.Name.Name
+		if _, ok := packages[key]; !ok {
+			order = append(order, key)
+		}
+
+		packages[key] = append(packages[key], file.File)
+	}
+
+	config := types.Config{
+		Importer: importer.ForCompiler(fileSet, "source", nil),
+		Error:    func(error) {},
+	}
+
+	for _, key := range order {
+		info := &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+		}
+
+		// Errors are collected by config.Error and ignored, the recorded information is still usable
+		_, _ = config.Check(key, fileSet, packages[key], info)
+
+		for _, file := range packages[key] {
+			infos[file] = info
+		}
+	}
+
+	return infos
+}
+
+// IsTypedMarkerField checks if a struct field is the SomeObject marker using type information,
+// comparing the full package path and name of the field's type, so import aliases,
+// dot imports and shadowed package names do not matter.
+//
+// Parameters:
+//   - info: The type information of the file declaring the struct
+//   - field: The AST struct field to check
+//   - fullPackage: The full import path of the marker package
+//   - markerField: The name of the marker field, usually "_"
+//   - declaredName: The marker type name
+//
+// Returns:
+//   - true if the field is the marker, false otherwise
+//   - true if the field's type is known, false if the caller should fall back to the AST
+func IsTypedMarkerField(info *types.Info, field *ast.Field, fullPackage string, markerField string, declaredName string) (bool, bool) {
+	if len(field.Names) != 1 || field.Names[0].Name != markerField {
+		return false, true
+	}
+
+	typ := info.TypeOf(field.Type)
+	if typ == nil || typ == types.Typ[types.Invalid] {
+		return false, false
+	}
+
+	if pointer, ok := typ.(*types.Pointer); ok {
+		typ = pointer.Elem()
+	}
+
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false, true
+	}
+
+	return named.Obj().Pkg().Path() == fullPackage && named.Obj().Name() == declaredName, true
+}
+
+// isTypedSomeObjectTypeDeclaration checks a struct for the SomeObject marker using type information.
+// The second result is false when a candidate marker field has no type information.
+func isTypedSomeObjectTypeDeclaration(info *types.Info, structType *ast.StructType, fullPackage string, markerField string, declaredName string) (bool, bool) {
+	if structType.Fields == nil {
+		return false, true
+	}
+
+	for _, field := range structType.Fields.List {
+		isMarker, known := IsTypedMarkerField(info, field, fullPackage, markerField, declaredName)
+		if !known {
+			return false, false
+		}
+
+		if isMarker {
+			return true, true
+		}
+	}
+
+	return false, true
+}
@@ -0,0 +1,157 @@
+package helpers
+
+import (
+	"go/ast"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// SplitTypeKey splits a report type key into the package and the type name.
+// Keys are either qualified with the full import path, e.g. "github.com/acme/shop/location.Location",
+// or use the short package name when no go.mod file was found, e.g. "location.Location".
+//
+// Parameters:
+//   - key: The type key
+//
+// Returns:
+//   - The import path, or the short package name for unqualified keys
+//   - The type name
+func SplitTypeKey(key string) (string, string) {
+	dot := strings.LastIndex(key, ".")
+	if dot < strings.LastIndex(key, "/") || dot < 0 {
+		return "", key
+	}
+
+	return key[:dot], key[dot+1:]
+}
+
+// shortTypeKey turns a qualified type key into the "package.TypeName" form used during discovery.
+func shortTypeKey(key string) string {
+	importPath, typeName := SplitTypeKey(key)
+	if importPath == "" {
+		return key
+	}
+
+	return path.Base(importPath) + "." + typeName
+}
+
+// importPath returns the cached import path of the package in dir, empty if no go.mod file was found.
+func (o *Options) importPath(dir string) string {
+	importPath, ok := o.importPaths[dir]
+	if !ok {
+		importPath, _ = ModuleImportPath(dir)
+
+		if o.importPaths == nil {
+			o.importPaths = make(map[string]string)
+		}
+
+		o.importPaths[dir] = importPath
+	}
+
+	return importPath
+}
+
+// qualifyTypeKey replaces the short package name of a type key with the import path of the file's package.
+func (o *Options) qualifyTypeKey(file string, typeKey string) string {
+	return o.qualifyTypeKeyInDir(filepath.Dir(file), typeKey)
+}
+
+// qualifyTypeKeyInDir replaces the short package name of a type key with the import path of the package in dir.
+func (o *Options) qualifyTypeKeyInDir(dir string, typeKey string) string {
+	importPath := o.importPath(dir)
+	if importPath == "" || typeKey == "" {
+		return typeKey
+	}
+
+	_, typeName := SplitTypeKey(typeKey)
+
+	return importPath + "." + typeName
+}
+
+// qualifyDeclaredTypeKey replaces the short package name of a type key reported in file with the import path
+// of the package declaring the type, see declaringDirs, so `orders.Money{}` written in another package
+// is still keyed by the orders package. When several packages declare the short key, the one of file wins,
+// types not declared in the parsed files are qualified with the package of file.
+func (o *Options) qualifyDeclaredTypeKey(declaring map[string][]string, file string, typeKey string) string {
+	dirs := declaring[typeKey]
+
+	switch {
+	case len(dirs) == 0:
+		return o.qualifyTypeKey(file, typeKey)
+	case len(dirs) == 1:
+		return o.qualifyTypeKeyInDir(dirs[0], typeKey)
+	}
+
+	if dir := filepath.Dir(file); slices.Contains(dirs, dir) {
+		return o.qualifyTypeKeyInDir(dir, typeKey)
+	}
+
+	return o.qualifyTypeKeyInDir(dirs[0], typeKey)
+}
+
+// declaringDirs returns the sorted directories of the parsed files declaring each of the discovered types.
+// Only structs matched by a TypeDeclaration predicate count once one has matched, so an unmarked type
+// sharing the short key of a discovered one, e.g. a legacy orders.Money, is not taken for its declaration.
+func (o *Options) declaringDirs(types map[string]bool) map[string][]string {
+	declaring := make(map[string][]string)
+
+	for _, source := range o.files {
+		ast.Inspect(source.File, func(n ast.Node) bool {
+			if isFunctionNode(n) {
+				return false
+			}
+
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			typeKey := source.File.Name.Name + "." + typeSpec.Name.Name
+			if _, ok := types[typeKey]; !ok {
+				return true
+			}
+
+			if structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType); o.declared != nil && (!ok || !o.declared[structType]) {
+				return true
+			}
+
+			if dir := filepath.Dir(source.Path); !slices.Contains(declaring[typeKey], dir) {
+				declaring[typeKey] = append(declaring[typeKey], dir)
+			}
+
+			return true
+		})
+	}
+
+	for _, dirs := range declaring {
+		sort.Strings(dirs)
+	}
+
+	return declaring
+}
+
+// qualifiedTypes rekeys discovered types by the import path of the packages declaring them, see declaringDirs.
+// Types that cannot be located in the parsed files keep their short key,
+// qualified keys filtered out by WithTypeNames are dropped.
+func (o *Options) qualifiedTypes(types map[string]bool, declaring map[string][]string) map[string]bool {
+	qualified := make(map[string]bool, len(types))
+
+	for typeKey, status := range types {
+		dirs := declaring[typeKey]
+		if len(dirs) == 0 {
+			qualified[typeKey] = status
+			continue
+		}
+
+		for _, dir := range dirs {
+			if qualifiedKey := o.qualifyTypeKeyInDir(dir, typeKey); o.IsTypeIncluded(qualifiedKey) {
+				qualified[qualifiedKey] = status
+			}
+		}
+	}
+
+	return qualified
+}
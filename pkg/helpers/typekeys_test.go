@@ -0,0 +1,49 @@
+package helpers
+
+import (
+	"testing"
+)
+
+func TestNewReportQualifiesWithDeclaringPackage(t *testing.T) {
+	const rootPath = "testdata/qualify"
+
+	options := NewOptions()
+	walk := options.Walker(rootPath)
+
+	types, err := FindTypeDeclarationsInWalk(walk, options.TypeDeclaration(valueObjectPackage, "_", "ValueObject"))
+	if err != nil {
+		t.Fatalf("FindTypeDeclarationsInWalk() error = %v", err)
+	}
+
+	constructors, err := FindConstructorsInWalk(walk, types)
+	if err != nil {
+		t.Fatalf("FindConstructorsInWalk() error = %v", err)
+	}
+
+	violations, err := FindZeroValueInitializationsInWalk(walk, "ValueObject", types, constructors)
+	if err != nil {
+		t.Fatalf("FindZeroValueInitializationsInWalk() error = %v", err)
+	}
+
+	report := NewReport(types, constructors, violations, options)
+
+	if len(report.Violations) != 1 {
+		t.Fatalf("NewReport() kept %d violations, want 1: %v", len(report.Violations), report.Violations)
+	}
+
+	// The unmarked internal/legacy/orders.Money shares the short key without being a Value Object
+	if len(report.Types) != 1 || !report.Types["example.com/fx/orders.Money"] {
+		t.Errorf("report types = %v, want only %q", report.Types, "example.com/fx/orders.Money")
+	}
+
+	if files := report.Files["example.com/fx/orders.Money"]; len(files) != 1 {
+		t.Errorf("report files = %v, want only the declaring file", report.Files)
+	}
+
+	// The violation is written in internal/app, the type is declared in orders
+	for _, violation := range report.Violations {
+		if violation.TypeName != "example.com/fx/orders.Money" {
+			t.Errorf("violation type = %q, want %q", violation.TypeName, "example.com/fx/orders.Money")
+		}
+	}
+}
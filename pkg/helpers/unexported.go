@@ -0,0 +1,90 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// isUnexportedConstructorName reports whether a function name looks like an unexported constructor, e.g. newMoney.
+func isUnexportedConstructorName(name string) bool {
+	rest, ok := strings.CutPrefix(name, "new")
+	if !ok {
+		return false
+	}
+
+	first, _ := utf8.DecodeRuneInString(rest)
+
+	return unicode.IsUpper(first)
+}
+
+// FindUnexportedOnlyConstructors reports SomeObject types without an exported constructor
+// that are built by unexported constructor-like functions such as `func newMoney(...) Money`,
+// so other packages can only bypass validation with a zero value.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//   - constructors: A map of constructor information, see FindConstructors
+//
+// Returns:
+//   - A map of violation messages to unexported-only constructor violations
+//   - An error if the scan fails, nil otherwise
+func FindUnexportedOnlyConstructors(walk Walker, checkName string, markerName string, locations map[string]*TypeLocation, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	exported := make(map[string]bool)
+	for key, constructor := range constructors {
+		if constructor.Copy {
+			continue
+		}
+
+		exported[key[strings.LastIndex(key, ":")+1:]] = true
+	}
+
+	// type -> "newMoney (money.go:12)", constructors live in the directory of the type
+	unexported := make(map[string][]string)
+
+	err := walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv != nil || !isUnexportedConstructorName(funcDecl.Name.Name) {
+				continue
+			}
+
+			typeKey, ok := resultTypeKey(currentPackage, funcDecl)
+			if !ok || locations[typeKey] == nil || exported[typeKey] {
+				continue
+			}
+
+			line := fileSet.Position(funcDecl.Pos()).Line
+			unexported[typeKey] = append(unexported[typeKey], fmt.Sprintf("%s (%s:%d)", funcDecl.Name.Name, filepath.Base(path), line))
+		}
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	for typeKey, constructorNames := range unexported {
+		location := locations[typeKey]
+
+		sort.Strings(constructorNames)
+
+		message := fmt.Sprintf("VIOLATION: %s %s has only unexported constructors %s at %s:%d (%s)", markerName, typeKey, strings.Join(constructorNames, ", "), location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FindUnusedExportedTypes reports exported SomeObjects that no other package of the scanned tree refers to,
+// neither by their type name nor through one of their constructors, which points at over-exposed or dead types.
+// Types declared in package main are skipped, they cannot be imported anyway.
+//
+// Parameters:
+//   - walk: The walker over the project's Go files
+//   - checkName: The identifier of the check reported with each violation
+//   - markerName: The SomeObject marker name used in violation messages
+//   - locations: A map of type names to their declaration location, see FindTypeLocations
+//   - constructors: A map of constructor names to their location information, see FindConstructors
+//
+// Returns:
+//   - A map of violation messages to unused exported type violations
+//   - An error if the scan fails, nil otherwise
+func FindUnusedExportedTypes(walk Walker, checkName string, markerName string, locations map[string]*TypeLocation, constructors map[string]*ConstructorInfo) (map[string]*Violation, error) {
+	violations := make(map[string]*Violation)
+
+	// "package.Name" of a type or one of its constructors -> type name
+	referenceNames := make(map[string]string)
+
+	for typeKey := range locations {
+		packageName, typeName := SplitTypeKey(typeKey)
+		if packageName == "main" || !ast.IsExported(typeName) {
+			continue
+		}
+
+		referenceNames[typeKey] = typeKey
+	}
+
+	for _, constructor := range constructors {
+		if _, ok := referenceNames[constructor.TypeName]; ok {
+			packageName, _ := SplitTypeKey(constructor.TypeName)
+			referenceNames[packageName+"."+constructor.Name] = constructor.TypeName
+		}
+	}
+
+	packages, err := BuildPackageIndex(walk)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	referenced := make(map[string]bool)
+
+	err = walk(func(path string, fileSet *token.FileSet, file *ast.File) {
+		currentPackage := file.Name.Name
+		dir := filepath.Dir(path)
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			selector, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+
+			// Package qualifiers are never resolved to local objects
+			if ident, ok := selector.X.(*ast.Ident); !ok || ident.Obj != nil {
+				return true
+			}
+
+			referenceKey, ok := resolveTypeKey(file, currentPackage, packages, selector)
+			if !ok {
+				return true
+			}
+
+			typeKey, ok := referenceNames[referenceKey]
+			if ok && filepath.Dir(locations[typeKey].File) != dir {
+				referenced[typeKey] = true
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	for typeKey, location := range locations {
+		if _, ok := referenceNames[typeKey]; !ok || referenced[typeKey] {
+			continue
+		}
+
+		message := fmt.Sprintf("VIOLATION: Exported %s %s is never referenced outside its package at %s:%d (%s)", markerName, typeKey, location.File, location.Line, checkName)
+		violations[message] = NewViolationAt(checkName, typeKey, location.File, location.Line, location.Column, message)
+	}
+
+	return violations, nil
+}
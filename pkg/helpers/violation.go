@@ -0,0 +1,69 @@
+package helpers
+
+// Violation describes a single problem found during analysis.
+//
+// Fields:
+//   - Check: Identifier of the check that produced the violation
+//   - Severity: How serious the violation is
+//   - TypeName: The offending type in format "package.TypeName", qualified with the import path by NewReport
+//   - File: The file where the violation was found
+//   - Line: The line where the violation was found
+//   - Column: The column where the violation was found, 0 when only the line is known
+//   - Message: Human-readable description, also used as the violation key in reports
+//   - Suggestion: A short suggested fix when enabled with WithSuggestions, see Suggest
+type Violation struct {
+	Check      string   `json:"check"`
+	Severity   Severity `json:"severity"`
+	TypeName   string   `json:"typeName"`
+	File       string   `json:"file"`
+	Line       int      `json:"line"`
+	Column     int      `json:"column,omitempty"`
+	Message    string   `json:"message"`
+	Suggestion string   `json:"suggestion,omitempty"`
+}
+
+// NewViolation creates a violation with the severity registered for the check.
+//
+// Parameters:
+//   - check: The check identifier
+//   - typeName: The offending type in format "package.TypeName"
+//   - file: The file where the violation was found
+//   - line: The line where the violation was found
+//   - message: Human-readable description of the violation
+//
+// Returns:
+//   - A new violation
+func NewViolation(check string, typeName string, file string, line int, message string) *Violation {
+	return &Violation{
+		Check:    check,
+		Severity: SeverityOf(check),
+		TypeName: typeName,
+		File:     file,
+		Line:     line,
+		Message:  message,
+	}
+}
+
+// NewViolationAt creates a violation like NewViolation, at the line and column of the offending node.
+//
+// Parameters:
+//   - check: The check identifier
+//   - typeName: The offending type in format "package.TypeName"
+//   - file: The file where the violation was found
+//   - line: The line where the violation was found
+//   - column: The column where the violation was found
+//   - message: Human-readable description of the violation
+//
+// Returns:
+//   - A new violation
+func NewViolationAt(check string, typeName string, file string, line int, column int, message string) *Violation {
+	violation := NewViolation(check, typeName, file, line, message)
+	violation.Column = column
+
+	return violation
+}
+
+// String returns the violation message.
+func (v *Violation) String() string {
+	return v.Message
+}
@@ -0,0 +1,479 @@
+package helpers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/build/constraint"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// FileVisitor is called for every parsed Go source file found during a project walk.
+//
+// Parameters:
+//   - path: The path of the parsed file, in forward slashes
+//   - fileSet: The file set used to parse the file, for position lookups
+//   - file: The parsed AST file
+type FileVisitor func(path string, fileSet *token.FileSet, file *ast.File)
+
+// Walker passes every Go source file of a project to visit.
+type Walker func(visit FileVisitor) error
+
+// SourceFile is a parsed Go source file.
+//
+// Fields:
+//   - Path: The path of the file, in forward slashes
+//   - FileSet: The file set used to parse the file, for position lookups
+//   - File: The parsed AST file
+type SourceFile struct {
+	Path    string
+	FileSet *token.FileSet
+	File    *ast.File
+}
+
+// isSourceFile reports whether a walked path is a Go file subject to validation.
+func isSourceFile(path string) bool {
+	if filepath.Ext(path) != ".go" {
+		return false
+	}
+
+	// Skip test files - we intentionally allow zero-value initializations in tests
+	// to provide flexibility for testing scenarios that don't require full domain validation
+	return !strings.HasSuffix(path, "_test.go")
+}
+
+// ScanError describes a file or directory a walk could not read, e.g. because of missing permissions,
+// so the scan covered less than the whole tree.
+//
+// Fields:
+//   - Path: The path that could not be read, in forward slashes
+//   - Message: The error reading it
+type ScanError struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// ScanErrorFunc receives every file or directory a walk could not read.
+type ScanErrorFunc func(scanError ScanError)
+
+// WalkGoFiles parses every non-test Go file under rootPath and passes it to visit
+// with its path in forward slashes, see filepath.ToSlash. Files that cannot be parsed are skipped,
+// so are files and directories that cannot be read, see WalkGoFilesReportingErrors.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - visit: The callback receiving each parsed file
+//
+// Returns:
+//   - An error wrapping ErrScanFailed if the walk fails, nil otherwise
+func WalkGoFiles(rootPath string, visit FileVisitor) error {
+	return WalkGoFilesReportingErrors(rootPath, visit, nil)
+}
+
+// WalkGoFilesReportingErrors walks like WalkGoFiles, passing the files and directories below rootPath
+// that cannot be read to onError instead of dropping them silently.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - visit: The callback receiving each parsed file
+//   - onError: The callback receiving each unreadable path, may be nil
+//
+// Returns:
+//   - An error wrapping ErrScanFailed if the walk fails, nil otherwise
+func WalkGoFilesReportingErrors(rootPath string, visit FileVisitor, onError ScanErrorFunc) error {
+	// One file set for the whole walk keeps positions of different files apart,
+	// which type checking a package across its files relies on
+	fileSet := token.NewFileSet()
+
+	skip := func(path string, err error) {
+		if onError != nil {
+			onError(ScanError{Path: filepath.ToSlash(path), Message: err.Error()})
+		}
+	}
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		// An unreadable root means nothing can be scanned at all
+		if err != nil && path == rootPath {
+			return err
+		}
+
+		if err != nil {
+			skip(path, err)
+
+			return nil
+		}
+
+		if !isSourceFile(path) {
+			return nil
+		}
+
+		src, err := os.ReadFile(path)
+		if err != nil {
+			skip(path, err)
+
+			return nil
+		}
+
+		// Paths use forward slashes on every platform, so constructor keys, violation files
+		// and the patterns matched against them agree on Windows too
+		slashPath := filepath.ToSlash(path)
+
+		file, err := parser.ParseFile(fileSet, slashPath, src, parser.ParseComments)
+		if err != nil {
+			return nil
+		}
+
+		visit(slashPath, fileSet, file)
+
+		return nil
+	})
+
+	if err != nil {
+		return ge.Pin(fmt.Errorf("%w: %w", ErrScanFailed, err))
+	}
+
+	return nil
+}
+
+// NewWalker returns a Walker that parses the files under rootPath on every walk.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//
+// Returns:
+//   - The walker
+func NewWalker(rootPath string) Walker {
+	return func(visit FileVisitor) error {
+		return WalkGoFiles(rootPath, visit)
+	}
+}
+
+// ImportsAnyPackage reports whether any non-test Go file under rootPath imports one of the given packages.
+// Only the import declarations are parsed and the walk stops at the first match, so it is a cheap pre-scan
+// letting projects that never import a marker package skip the full parse.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - importPaths: The import paths to look for
+//
+// Returns:
+//   - true if a file imports one of the packages, false otherwise
+//   - An error wrapping ErrScanFailed if the walk fails, nil otherwise
+func ImportsAnyPackage(rootPath string, importPaths []string) (bool, error) {
+	found := false
+	fileSet := token.NewFileSet()
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil && path == rootPath {
+			return err
+		}
+
+		if err != nil || !isSourceFile(path) {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fileSet, path, nil, parser.ImportsOnly)
+		if err != nil {
+			return nil
+		}
+
+		if importsAny(file, importPaths) {
+			found = true
+
+			return filepath.SkipAll
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return false, ge.Pin(fmt.Errorf("%w: %w", ErrScanFailed, err))
+	}
+
+	return found, nil
+}
+
+// importsAny reports whether a file imports one of the given packages.
+func importsAny(file *ast.File, importPaths []string) bool {
+	for _, imp := range file.Imports {
+		if isOneOf(strings.Trim(imp.Path.Value, `"`), importPaths) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CountGoFiles counts the Go files under rootPath that a walk would parse.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//
+// Returns:
+//   - The number of files
+//   - An error wrapping ErrScanFailed if the walk fails, nil otherwise
+func CountGoFiles(rootPath string) (int, error) {
+	count := 0
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil && path == rootPath {
+			return err
+		}
+
+		if err == nil && isSourceFile(path) {
+			count++
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, ge.Pin(fmt.Errorf("%w: %w", ErrScanFailed, err))
+	}
+
+	return count, nil
+}
+
+// ParseGoFiles parses every non-test Go file under rootPath.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - progress: Optional callback invoked after each parsed file, may be nil
+//
+// Returns:
+//   - The parsed files
+//   - An error wrapping ErrScanFailed if the walk fails, nil otherwise
+func ParseGoFiles(rootPath string, progress ProgressFunc) ([]*SourceFile, error) {
+	return ParseGoFilesReportingErrors(rootPath, progress, nil)
+}
+
+// ParseGoFilesReportingErrors parses like ParseGoFiles, passing the files and directories below rootPath
+// that cannot be read to onError, see WalkGoFilesReportingErrors.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go files
+//   - progress: Optional callback invoked after each parsed file, may be nil
+//   - onError: Optional callback receiving each unreadable path, may be nil
+//
+// Returns:
+//   - The parsed files
+//   - An error wrapping ErrScanFailed if the walk fails, nil otherwise
+func ParseGoFilesReportingErrors(rootPath string, progress ProgressFunc, onError ScanErrorFunc) ([]*SourceFile, error) {
+	total := -1
+
+	if progress != nil {
+		count, err := CountGoFiles(rootPath)
+		if err == nil {
+			total = count
+		}
+	}
+
+	var files []*SourceFile
+
+	scanned := 0
+
+	err := WalkGoFilesReportingErrors(rootPath, func(path string, fileSet *token.FileSet, file *ast.File) {
+		files = append(files, &SourceFile{
+			Path:    path,
+			FileSet: fileSet,
+			File:    file,
+		})
+
+		scanned++
+
+		if progress != nil {
+			progress(scanned, total)
+		}
+	}, onError)
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return files, nil
+}
+
+// unixOS lists the GOOS values the "unix" build tag matches.
+var unixOS = map[string]bool{
+	"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true, "hurd": true, "illumos": true,
+	"ios": true, "linux": true, "netbsd": true, "openbsd": true, "solaris": true,
+}
+
+// MatchBuildTags drops the files excluded by their build constraints, `//go:build` lines
+// and GOOS or GOARCH file name suffixes, when building with the given tags for the current platform.
+// Constraints are read from the comments of the parsed files, so files given with WithParsedFiles
+// or WithParsedPackages are matched without reading the file system.
+//
+// Parameters:
+//   - files: The parsed files
+//   - tags: The active build tags, e.g. "enterprise"
+//
+// Returns:
+//   - The files included in the build
+func MatchBuildTags(files []*SourceFile, tags []string) []*SourceFile {
+	context := build.Default
+	context.BuildTags = tags
+	// MatchFile opens files only after their name matched, hand it the package clause alone,
+	// the constraints of the header are evaluated from the parsed comments below
+	context.OpenFile = func(string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("package p\n")), nil
+	}
+
+	var matched []*SourceFile
+
+	for _, file := range files {
+		path := filepath.FromSlash(file.Path)
+
+		if ok, err := context.MatchFile(filepath.Dir(path), filepath.Base(path)); err != nil || !ok {
+			continue
+		}
+
+		if matchBuildConstraint(context, file.File) {
+			matched = append(matched, file)
+		}
+	}
+
+	return matched
+}
+
+// matchBuildConstraint evaluates the `//go:build` line above the package clause of file,
+// or its legacy `// +build` lines if there is none, true if there is neither.
+func matchBuildConstraint(context build.Context, file *ast.File) bool {
+	var plusBuild []constraint.Expr
+
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			break
+		}
+
+		for _, comment := range group.List {
+			if constraint.IsGoBuild(comment.Text) {
+				expr, err := constraint.Parse(comment.Text)
+				if err != nil {
+					// The compiler rejects the file, keep it rather than guess
+					return true
+				}
+
+				return expr.Eval(func(tag string) bool { return matchBuildTag(context, tag) })
+			}
+
+			if constraint.IsPlusBuild(comment.Text) {
+				if expr, err := constraint.Parse(comment.Text); err == nil {
+					plusBuild = append(plusBuild, expr)
+				}
+			}
+		}
+	}
+
+	for _, expr := range plusBuild {
+		if !expr.Eval(func(tag string) bool { return matchBuildTag(context, tag) }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchBuildTag reports whether a build with context satisfies tag, the way go/build does:
+// the platform, its implied platforms such as "linux" on android, "unix", cgo, the compiler,
+// the Go release tags and the active build tags.
+func matchBuildTag(context build.Context, tag string) bool {
+	switch {
+	case tag == context.GOOS || tag == context.GOARCH || tag == context.Compiler:
+		return true
+	case tag == "unix":
+		return unixOS[context.GOOS]
+	case tag == "cgo":
+		return context.CgoEnabled
+	case tag == "linux":
+		return context.GOOS == "android"
+	case tag == "solaris":
+		return context.GOOS == "illumos"
+	case tag == "darwin":
+		return context.GOOS == "ios"
+	}
+
+	return slices.Contains(context.ReleaseTags, tag) || slices.Contains(context.ToolTags, tag) || slices.Contains(context.BuildTags, tag)
+}
+
+// ParsedFiles wraps files parsed elsewhere, e.g. by go/packages, as SourceFiles with their paths
+// in forward slashes, so discovery and checks can run over them without reading the file system.
+// Test files are skipped the same way a walk of the file system skips them.
+//
+// Parameters:
+//   - fileSet: The file set the files were parsed with, shared by all of them
+//   - files: The parsed files
+//
+// Returns:
+//   - The source files, never nil
+func ParsedFiles(fileSet *token.FileSet, files ...*ast.File) []*SourceFile {
+	sources := make([]*SourceFile, 0, len(files))
+
+	for _, file := range files {
+		path := filepath.ToSlash(fileSet.Position(file.Package).Filename)
+		if !isSourceFile(path) {
+			continue
+		}
+
+		sources = append(sources, &SourceFile{
+			Path:    path,
+			FileSet: fileSet,
+			File:    file,
+		})
+	}
+
+	return sources
+}
+
+// ParsedPackages wraps the files of packages parsed elsewhere as SourceFiles, see ParsedFiles.
+//
+// Parameters:
+//   - fileSet: The file set the packages were parsed with, shared by all of them
+//   - packages: The parsed packages by name or path
+//
+// Returns:
+//   - The source files sorted by path, never nil
+func ParsedPackages(fileSet *token.FileSet, packages map[string]*ast.Package) []*SourceFile {
+	var files []*ast.File
+
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+	}
+
+	sources := ParsedFiles(fileSet, files...)
+
+	// Map iteration order is random, walks should not be
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Path < sources[j].Path
+	})
+
+	return sources
+}
+
+// NewFilesWalker returns a Walker over already parsed files.
+//
+// Parameters:
+//   - files: The parsed files
+//
+// Returns:
+//   - The walker
+func NewFilesWalker(files []*SourceFile) Walker {
+	return func(visit FileVisitor) error {
+		for _, file := range files {
+			visit(file.Path, file.FileSet, file.File)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,91 @@
+package helpers
+
+import (
+	"go/ast"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWalkerReportsUnreadableDirectory(t *testing.T) {
+	const (
+		rootPath   = "testdata/unreadable"
+		lockedPath = rootPath + "/locked"
+	)
+
+	if os.Geteuid() == 0 {
+		t.Skip("root reads directories regardless of their permissions")
+	}
+
+	if err := os.Chmod(lockedPath, 0o000); err != nil {
+		t.Fatalf("os.Chmod() error = %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := os.Chmod(lockedPath, 0o755); err != nil {
+			t.Errorf("os.Chmod() error = %v", err)
+		}
+	})
+
+	for _, strict := range []bool{false, true} {
+		options := NewOptions(WithStrictScan(strict))
+
+		var visited []string
+
+		err := options.Walker(rootPath)(func(path string, _ *token.FileSet, _ *ast.File) {
+			visited = append(visited, path)
+		})
+		if err != nil {
+			t.Fatalf("walk error = %v", err)
+		}
+
+		if len(visited) != 1 || !strings.HasSuffix(visited[0], "shop/shop.go") {
+			t.Errorf("visited = %v, want only the readable shop/shop.go", visited)
+		}
+
+		report := NewReport(nil, nil, nil, options)
+
+		if len(report.ScanErrors) != 1 || !strings.HasSuffix(report.ScanErrors[0].Path, "locked") {
+			t.Fatalf("scan errors = %v, want the locked directory", report.ScanErrors)
+		}
+
+		if report.Failed() != strict {
+			t.Errorf("Failed() = %v with strict scan %v, want %v", report.Failed(), strict, strict)
+		}
+	}
+}
+
+// BenchmarkMarkerPreScan compares a run over a project without markers with and without the import pre-scan,
+// see ImportsAnyPackage: the pre-scan parses import declarations only, the full run parses and inspects every file.
+func BenchmarkMarkerPreScan(b *testing.B) {
+	const rootPath = "testdata/markerfree"
+
+	isTypeDeclaration := NewOptions().TypeDeclaration(valueObjectPackage, "_", "ValueObject")
+
+	b.Run("with pre-scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			found, err := ImportsAnyPackage(rootPath, []string{valueObjectPackage})
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			if found {
+				b.Fatal("ImportsAnyPackage() found a marker import in a marker-free tree")
+			}
+		}
+	})
+
+	b.Run("without pre-scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			types, err := FindTypeDeclarationsInWalk(NewWalker(rootPath), isTypeDeclaration)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			if len(types) != 0 {
+				b.Fatalf("FindTypeDeclarationsInWalk() found %d types in a marker-free tree", len(types))
+			}
+		}
+	})
+}
@@ -4,6 +4,10 @@ import (
 	"go/ast"
 
 	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/entity"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/objects/commands"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/patterns/repository"
+	"github.com/nobuenhombre/suikat/pkg/ge"
 )
 
 type Aggregate struct{}
@@ -15,6 +19,23 @@ const (
 	DeclaredRootName = "AggregateRoot"
 	MarkerField      = "_"
 	FullPackage      = "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/aggregate"
+
+	// CheckAggregateInternalSetter flags exported mutating methods on non-root Aggregate types.
+	CheckAggregateInternalSetter = helpers.CheckAggregateInternalSetter
+
+	// CheckAggregateExposesCollection flags AggregateRoot methods returning collections of internal Aggregates or Entities.
+	CheckAggregateExposesCollection = helpers.CheckAggregateExposesCollection
+
+	// CheckAggregateExternalMutation flags AggregateRoot fields assigned outside the methods of the root.
+	CheckAggregateExternalMutation = helpers.CheckAggregateExternalMutation
+
+	// CheckAggregateWithoutRepository flags AggregateRoot types no Repository method accepts or returns.
+	// It is an architecture rule and only runs when enabled with helpers.WithEnabledChecks.
+	CheckAggregateWithoutRepository = helpers.CheckAggregateWithoutRepository
+
+	// CheckAggregateRootUnreachable flags AggregateRoot types no Command handler references.
+	// It is an architecture rule and only runs when enabled with helpers.WithEnabledChecks.
+	CheckAggregateRootUnreachable = helpers.CheckAggregateRootUnreachable
 )
 
 // IsAggregateTypeDeclaration checks if a struct type contains the Aggregate marker field named "_".
@@ -40,3 +61,408 @@ func IsAggregateTypeDeclaration(file *ast.File, structType *ast.StructType) bool
 func IsAggregateRootTypeDeclaration(file *ast.File, structType *ast.StructType) bool {
 	return helpers.IsSomeObjectTypeDeclaration(file, structType, FullPackage, MarkerField, DeclaredRootName)
 }
+
+// ValidateAggregatesReport contains the results of aggregate validation analysis.
+//
+// The report provides detailed information about discovered aggregate and aggregate root types,
+// their constructor functions, and any validation violations found during analysis.
+//
+// Fields (promoted from helpers.Report):
+//   - SchemaVersion: The version of the report shape, see helpers.ReportSchemaVersion
+//   - Types: Map of discovered aggregate type names to their validation status
+//   - Constructors: Map of constructor function names to detailed constructor information
+//   - Files: Map of type names to the files declaring the type, its constructors and methods
+//   - Violations: Map of violation messages to the violation details
+//   - Counts: Number of violations per severity
+//   - MinSeverity: The lowest severity that makes the report fail
+type ValidateAggregatesReport struct {
+	helpers.Report
+}
+
+// ValidateAggregates analyzes Go source code to validate aggregate patterns.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - opts: Optional settings such as helpers.WithMinSeverity, overriding the project configuration file
+//
+// Returns:
+//   - *ValidateAggregatesReport: A detailed report containing found types, constructors, and violations
+//   - error: An error if the validation process fails, nil otherwise
+//
+// The function performs twenty-six main steps:
+//  1. Discovers aggregate and aggregate root type declarations in the codebase
+//  2. Identifies constructor functions for the discovered types
+//  3. Detects violations where zero values might be incorrectly initialized
+//  4. Detects zero values declared and then filled field by field
+//  5. Detects markers misconfigured as pointers
+//  6. Detects markers used outside the marker field of a struct
+//  7. Detects values created through reflect.New or reflect.Zero
+//  8. Detects types declared in package main
+//  9. Detects stereotype types declared inside function bodies
+//  10. Detects files importing the marker package without any marked type
+//  11. Detects types without any constructor
+//  12. Detects types with only unexported constructors
+//  13. Detects embedded types promoting foreign fields and methods
+//  14. Detects fields holding sync types or channels
+//  15. Detects types implementing the error interface
+//  16. Detects non-root aggregates exposing mutating methods that bypass the root
+//  17. Detects aggregate roots returning collections of internal aggregates or entities
+//  18. Detects aggregate root fields assigned outside the methods of the root
+//  19. Optionally detects aggregate roots without a repository accepting or returning them
+//  20. Optionally detects aggregate roots no command handler references
+//  21. Optionally detects types constructed only in test files
+//  22. Optionally detects exported types never referenced outside their package
+//  23. Optionally detects trivial constructors that only return a zero value
+//  24. Optionally detects constructors discarding errors with the blank identifier
+//  25. Optionally detects marker fields followed by other fields
+//  26. Optionally detects data fields named like the marker
+//
+// Returns nil if no aggregate types, local declarations and marker misuses included, are found in the specified directory.
+func ValidateAggregates(rootPath string, opts ...helpers.Option) (*ValidateAggregatesReport, error) {
+	options, err := helpers.LoadOptions(rootPath, opts...)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	// Projects never importing the marker package have nothing to validate, skip parsing them
+	hasMarkers, err := options.ImportsAnyMarker(rootPath, append(options.Markers(DeclaredName, FullPackage), options.Markers(DeclaredRootName, FullPackage)...))
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	if !hasMarkers {
+		return nil, nil
+	}
+
+	walk := options.Walker(rootPath)
+	stopTypeDiscovery := options.Metrics.Track(helpers.PhaseTypeDiscovery)
+	isAggregateTypeDeclaration := options.TypeDeclaration(FullPackage, MarkerField, DeclaredName)
+	isAggregateRootTypeDeclaration := options.TypeDeclaration(FullPackage, MarkerField, DeclaredRootName)
+
+	internalTypes, hookViolations, err := helpers.FindTypeDeclarationsWithChecks(walk, DeclaredName, isAggregateTypeDeclaration, options.TypeChecks)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	internalTypes = options.FilterTypes(internalTypes)
+
+	rootTypes, rootHookViolations, err := helpers.FindTypeDeclarationsWithChecks(walk, DeclaredRootName, isAggregateRootTypeDeclaration, options.TypeChecks)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	rootTypes = options.FilterTypes(rootTypes)
+
+	localViolations, err := helpers.FindLocalTypeDeclarations(walk, helpers.CheckLocalStereotypeDeclaration, DeclaredName, isAggregateTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	localRootViolations, err := helpers.FindLocalTypeDeclarations(walk, helpers.CheckLocalStereotypeDeclaration, DeclaredRootName, isAggregateRootTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	misuseViolations, err := helpers.FindMarkerMisuses(walk, helpers.CheckMarkerMisuse, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	rootMisuseViolations, err := helpers.FindMarkerMisuses(walk, helpers.CheckMarkerMisuse, options.MarkerPackage(DeclaredRootName, FullPackage), MarkerField, DeclaredRootName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(localViolations, localRootViolations)
+	helpers.MergeViolations(misuseViolations, rootMisuseViolations)
+	helpers.MergeViolations(hookViolations, rootHookViolations)
+
+	types := make(map[string]bool, len(internalTypes)+len(rootTypes))
+
+	for typeName := range internalTypes {
+		types[typeName] = true
+	}
+
+	for typeName := range rootTypes {
+		types[typeName] = true
+	}
+
+	stopTypeDiscovery()
+
+	if len(types) == 0 && len(localViolations) == 0 && len(misuseViolations) == 0 {
+		return nil, nil
+	}
+
+	locations, err := helpers.FindTypeLocations(walk, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopConstructorDiscovery := options.Metrics.Track(helpers.PhaseConstructorDiscovery)
+	constructors, err := helpers.FindConstructorsWithFactories(walk, types, options.InterfaceFactories)
+	stopConstructorDiscovery()
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopViolationDetection := options.Metrics.Track(helpers.PhaseViolationDetection)
+
+	violations, err := helpers.FindZeroValueInitializationsWithScope(walk, DeclaredName, types, constructors, options.StrictConstructorScope)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	piecemealViolations, err := helpers.FindPiecemealConstructions(walk, helpers.CheckPiecemealConstruction, DeclaredName, types, constructors)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, piecemealViolations)
+
+	pointerViolations, err := helpers.FindPointerMarkersInWalk(walk, helpers.CheckPointerMarker, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, pointerViolations)
+
+	rootPointerViolations, err := helpers.FindPointerMarkersInWalk(walk, helpers.CheckPointerMarker, options.MarkerPackage(DeclaredRootName, FullPackage), MarkerField, DeclaredRootName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, rootPointerViolations)
+
+	helpers.MergeViolations(violations, misuseViolations)
+
+	reflectiveViolations, err := helpers.FindReflectiveConstructions(walk, helpers.CheckReflectiveConstruction, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, reflectiveViolations)
+
+	helpers.MergeViolations(violations, helpers.FindStereotypesInMain(helpers.CheckStereotypeInMain, DeclaredName, locations))
+	helpers.MergeViolations(violations, localViolations)
+	helpers.MergeViolations(violations, hookViolations)
+
+	isMarkedTypeDeclaration := func(file *ast.File, structType *ast.StructType) bool {
+		return isAggregateTypeDeclaration(file, structType) || isAggregateRootTypeDeclaration(file, structType)
+	}
+
+	markerImportViolations, err := helpers.FindUnusedMarkerImports(walk, helpers.CheckUnusedMarkerImport, DeclaredName, options.MarkerPackage(DeclaredName, FullPackage), []string{DeclaredName, DeclaredRootName}, isMarkedTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, markerImportViolations)
+
+	helpers.MergeViolations(violations, helpers.FindTypesWithoutConstructors(helpers.CheckNoConstructor, DeclaredName, locations, constructors))
+
+	unexportedViolations, err := helpers.FindUnexportedOnlyConstructors(walk, helpers.CheckUnexportedOnlyConstructor, DeclaredName, locations, constructors)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, unexportedViolations)
+
+	embeddingViolations, err := helpers.FindEmbeddedTypes(walk, helpers.CheckStereotypeEmbedding, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, embeddingViolations)
+
+	concurrencyViolations, err := helpers.FindConcurrencyFields(walk, helpers.CheckStereotypeConcurrencyField, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, concurrencyViolations)
+
+	errorViolations, err := helpers.FindErrorImplementations(walk, helpers.CheckStereotypeImplementsError, DeclaredName, types, options.ErrorTypes)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, errorViolations)
+
+	setterViolations, err := helpers.FindExportedMutators(walk, CheckAggregateInternalSetter, DeclaredName, internalTypes)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, setterViolations)
+
+	collectionViolations, err := findExposedCollections(walk, options, rootTypes, internalTypes)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, collectionViolations)
+
+	mutationViolations, err := helpers.FindExternalMutations(walk, CheckAggregateExternalMutation, DeclaredRootName, rootTypes, constructors)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, mutationViolations)
+
+	if options.IsCheckEnabled(CheckAggregateWithoutRepository) {
+		repositoryViolations, err := findAggregatesWithoutRepository(walk, options, locations, rootTypes)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, repositoryViolations)
+	}
+
+	if options.IsCheckEnabled(CheckAggregateRootUnreachable) {
+		unreachableViolations, err := findUnreachableAggregateRoots(walk, options, locations, rootTypes, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, unreachableViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckConstructorOnlyInTests) {
+		testOnlyViolations, err := helpers.FindTestOnlyConstructors(rootPath, helpers.CheckConstructorOnlyInTests, DeclaredName, locations, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, testOnlyViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckUnusedExportedStereotype) {
+		unusedViolations, err := helpers.FindUnusedExportedTypes(walk, helpers.CheckUnusedExportedStereotype, DeclaredName, locations, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, unusedViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckTrivialConstructor) {
+		trivialViolations, err := helpers.FindTrivialConstructors(walk, helpers.CheckTrivialConstructor, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, trivialViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckConstructorIgnoresError) {
+		ignoredViolations, err := helpers.FindIgnoredErrors(walk, helpers.CheckConstructorIgnoresError, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, ignoredViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckMarkerNotLast) {
+		for _, declaredName := range []string{DeclaredName, DeclaredRootName} {
+			markerViolations, err := helpers.FindMisplacedMarkers(walk, helpers.CheckMarkerNotLast, options.MarkerPackage(declaredName, FullPackage), MarkerField, declaredName)
+			if err != nil {
+				return nil, ge.Pin(err)
+			}
+
+			helpers.MergeViolations(violations, markerViolations)
+		}
+	}
+
+	if options.IsCheckEnabled(helpers.CheckShadowedMarkerName) {
+		shadowViolations, err := helpers.FindShadowedMarkerNames(walk, helpers.CheckShadowedMarkerName, DeclaredName, MarkerField, internalTypes, DeclaredName, DeclaredRootName)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, shadowViolations)
+
+		rootShadowViolations, err := helpers.FindShadowedMarkerNames(walk, helpers.CheckShadowedMarkerName, DeclaredRootName, MarkerField, rootTypes, DeclaredName, DeclaredRootName)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, rootShadowViolations)
+	}
+
+	stopViolationDetection()
+
+	return &ValidateAggregatesReport{
+		Report: *helpers.NewReport(types, constructors, violations, options),
+	}, nil
+}
+
+// findExposedCollections detects aggregate root methods such as `func (o *Order) Lines() []*OrderLine`
+// handing out collections of internal aggregates or entities.
+func findExposedCollections(walk helpers.Walker, options *helpers.Options, rootTypes map[string]bool, internalTypes map[string]bool) (map[string]*helpers.Violation, error) {
+	isEntityTypeDeclaration := options.TypeDeclaration(entity.FullPackage, entity.MarkerField, entity.DeclaredName)
+
+	entityTypes, err := helpers.FindTypeDeclarationsInWalk(walk, isEntityTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	elementTypes := make(map[string]bool, len(internalTypes)+len(entityTypes))
+
+	for typeName := range internalTypes {
+		elementTypes[typeName] = true
+	}
+
+	for typeName := range entityTypes {
+		elementTypes[typeName] = true
+	}
+
+	return helpers.FindExposedCollections(walk, CheckAggregateExposesCollection, DeclaredRootName, rootTypes, elementTypes)
+}
+
+// findAggregatesWithoutRepository detects aggregate roots that no method of a type marked as Repository,
+// such as `func (r *OrderRepository) Save(order *Order) error`, accepts or returns.
+func findAggregatesWithoutRepository(walk helpers.Walker, options *helpers.Options, locations map[string]*helpers.TypeLocation, rootTypes map[string]bool) (map[string]*helpers.Violation, error) {
+	isRepositoryTypeDeclaration := options.TypeDeclaration(repository.FullPackage, repository.MarkerField, repository.DeclaredName)
+
+	repositoryTypes, err := helpers.FindTypeDeclarationsInWalk(walk, isRepositoryTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	rootLocations := make(map[string]*helpers.TypeLocation, len(rootTypes))
+
+	for typeName := range rootTypes {
+		if location, ok := locations[typeName]; ok {
+			rootLocations[typeName] = location
+		}
+	}
+
+	return helpers.FindUnreferencedTypes(walk, CheckAggregateWithoutRepository, DeclaredRootName, repository.DeclaredName, rootLocations, repositoryTypes)
+}
+
+// findUnreachableAggregateRoots detects aggregate roots that no command handler,
+// such as `func (h *PlaceOrderHandler) Handle(ctx context.Context, cmd PlaceOrder) error`, references.
+func findUnreachableAggregateRoots(walk helpers.Walker, options *helpers.Options, locations map[string]*helpers.TypeLocation, rootTypes map[string]bool, constructors map[string]*helpers.ConstructorInfo) (map[string]*helpers.Violation, error) {
+	isCommandTypeDeclaration := options.TypeDeclaration(commands.FullPackage, commands.MarkerField, commands.DeclaredName)
+
+	commandTypes, err := helpers.FindTypeDeclarationsInWalk(walk, isCommandTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	handlers, err := helpers.FindHandlers(walk, commandTypes)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	rootLocations := make(map[string]*helpers.TypeLocation, len(rootTypes))
+
+	for typeName := range rootTypes {
+		if location, ok := locations[typeName]; ok {
+			rootLocations[typeName] = location
+		}
+	}
+
+	return helpers.FindUnhandledTypes(walk, CheckAggregateRootUnreachable, DeclaredRootName, commands.DeclaredName, rootLocations, handlers, constructors)
+}
@@ -4,6 +4,8 @@ import (
 	"go/ast"
 
 	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	valueobject "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+	"github.com/nobuenhombre/suikat/pkg/ge"
 )
 
 type Entity struct{}
@@ -12,6 +14,23 @@ const (
 	DeclaredName = "Entity"
 	MarkerField  = "_"
 	FullPackage  = "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/entity"
+
+	// CheckEntityAsMapKey flags map types keyed by Entity values.
+	CheckEntityAsMapKey = helpers.CheckEntityAsMapKey
+
+	// CheckValueObjectMutationViaEntity flags Value Objects mutated through pointer fields of Entities.
+	CheckValueObjectMutationViaEntity = helpers.CheckValueObjectMutationViaEntity
+
+	// CheckValueObjectReplaced flags Entity methods assigning a new Value Object to a pointer field of the receiver.
+	CheckValueObjectReplaced = helpers.CheckValueObjectReplaced
+
+	// CheckAnemicEntity flags Entities without behavior besides trivial getters.
+	// It is advisory and only runs when enabled with helpers.WithEnabledChecks.
+	CheckAnemicEntity = helpers.CheckAnemicEntity
+
+	// CheckPossibleNilValueObject flags Entity methods dereferencing pointer fields to Value Objects without a nil check.
+	// It is heuristic and only runs when enabled with helpers.WithEnabledChecks.
+	CheckPossibleNilValueObject = helpers.CheckPossibleNilValueObject
 )
 
 // IsEntityTypeDeclaration checks if a struct type contains the Entity marker field named "_".
@@ -25,3 +44,353 @@ const (
 func IsEntityTypeDeclaration(file *ast.File, structType *ast.StructType) bool {
 	return helpers.IsSomeObjectTypeDeclaration(file, structType, FullPackage, MarkerField, DeclaredName)
 }
+
+// ValidateEntitiesReport contains the results of entity validation analysis.
+//
+// The report provides detailed information about discovered entity types,
+// their constructor functions, and any validation violations found during analysis.
+//
+// Fields (promoted from helpers.Report):
+//   - SchemaVersion: The version of the report shape, see helpers.ReportSchemaVersion
+//   - Types: Map of discovered entity type names to their validation status
+//   - Constructors: Map of constructor function names to detailed constructor information
+//   - Files: Map of type names to the files declaring the type, its constructors and methods
+//   - Violations: Map of violation messages to the violation details
+//   - Counts: Number of violations per severity
+//   - MinSeverity: The lowest severity that makes the report fail
+type ValidateEntitiesReport struct {
+	helpers.Report
+}
+
+// ValidateEntities analyzes Go source code to validate entity patterns.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - opts: Optional settings such as helpers.WithMinSeverity, overriding the project configuration file
+//
+// Returns:
+//   - *ValidateEntitiesReport: A detailed report containing found types, constructors, and violations
+//   - error: An error if the validation process fails, nil otherwise
+//
+// The function performs twenty-six main steps:
+//  1. Discovers entity type declarations in the codebase
+//  2. Identifies constructor functions for the discovered types
+//  3. Detects violations where zero values might be incorrectly initialized
+//  4. Detects zero values declared and then filled field by field
+//  5. Detects markers misconfigured as pointers
+//  6. Detects markers used outside the marker field of a struct
+//  7. Detects values created through reflect.New or reflect.Zero
+//  8. Detects types declared in package main
+//  9. Detects stereotype types declared inside function bodies
+//  10. Detects files importing the marker package without any marked type
+//  11. Detects types without any constructor
+//  12. Detects types with only unexported constructors
+//  13. Detects embedded types promoting foreign fields and methods
+//  14. Detects fields holding sync types or channels
+//  15. Detects types implementing the error interface
+//  16. Detects entities used as map keys, which relies on struct equality instead of identity
+//  17. Detects value objects mutated through pointer fields of entities
+//  18. Detects value objects replaced through pointer fields in entity methods
+//  19. Optionally detects pointer fields to value objects dereferenced without a nil check
+//  20. Optionally detects anemic entities without behavior besides trivial getters
+//  21. Optionally detects types constructed only in test files
+//  22. Optionally detects exported types never referenced outside their package
+//  23. Optionally detects trivial constructors that only return a zero value
+//  24. Optionally detects constructors discarding errors with the blank identifier
+//  25. Optionally detects marker fields followed by other fields
+//  26. Optionally detects data fields named like the marker
+//
+// Returns nil if no entity types, local declarations and marker misuses included, are found in the specified directory.
+func ValidateEntities(rootPath string, opts ...helpers.Option) (*ValidateEntitiesReport, error) {
+	options, err := helpers.LoadOptions(rootPath, opts...)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	// Projects never importing the marker package have nothing to validate, skip parsing them
+	hasMarkers, err := options.ImportsAnyMarker(rootPath, options.Markers(DeclaredName, FullPackage))
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	if !hasMarkers {
+		return nil, nil
+	}
+
+	walk := options.Walker(rootPath)
+	stopTypeDiscovery := options.Metrics.Track(helpers.PhaseTypeDiscovery)
+	isTypeDeclaration := options.TypeDeclaration(FullPackage, MarkerField, DeclaredName)
+
+	types, hookViolations, err := helpers.FindTypeDeclarationsWithChecks(walk, DeclaredName, isTypeDeclaration, options.TypeChecks)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	types = options.FilterTypes(types)
+
+	localViolations, err := helpers.FindLocalTypeDeclarations(walk, helpers.CheckLocalStereotypeDeclaration, DeclaredName, isTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	misuseViolations, err := helpers.FindMarkerMisuses(walk, helpers.CheckMarkerMisuse, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopTypeDiscovery()
+
+	if len(types) == 0 && len(localViolations) == 0 && len(misuseViolations) == 0 {
+		return nil, nil
+	}
+
+	locations, err := helpers.FindTypeLocations(walk, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopConstructorDiscovery := options.Metrics.Track(helpers.PhaseConstructorDiscovery)
+	constructors, err := helpers.FindConstructorsWithFactories(walk, types, options.InterfaceFactories)
+	stopConstructorDiscovery()
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopViolationDetection := options.Metrics.Track(helpers.PhaseViolationDetection)
+
+	violations, err := helpers.FindZeroValueInitializationsWithScope(walk, DeclaredName, types, constructors, options.StrictConstructorScope)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	piecemealViolations, err := helpers.FindPiecemealConstructions(walk, helpers.CheckPiecemealConstruction, DeclaredName, types, constructors)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, piecemealViolations)
+
+	pointerViolations, err := helpers.FindPointerMarkersInWalk(walk, helpers.CheckPointerMarker, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, pointerViolations)
+
+	helpers.MergeViolations(violations, misuseViolations)
+
+	reflectiveViolations, err := helpers.FindReflectiveConstructions(walk, helpers.CheckReflectiveConstruction, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, reflectiveViolations)
+
+	helpers.MergeViolations(violations, helpers.FindStereotypesInMain(helpers.CheckStereotypeInMain, DeclaredName, locations))
+	helpers.MergeViolations(violations, localViolations)
+	helpers.MergeViolations(violations, hookViolations)
+
+	markerImportViolations, err := helpers.FindUnusedMarkerImports(walk, helpers.CheckUnusedMarkerImport, DeclaredName, options.MarkerPackage(DeclaredName, FullPackage), []string{DeclaredName}, isTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, markerImportViolations)
+
+	helpers.MergeViolations(violations, helpers.FindTypesWithoutConstructors(helpers.CheckNoConstructor, DeclaredName, locations, constructors))
+
+	unexportedViolations, err := helpers.FindUnexportedOnlyConstructors(walk, helpers.CheckUnexportedOnlyConstructor, DeclaredName, locations, constructors)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, unexportedViolations)
+
+	embeddingViolations, err := helpers.FindEmbeddedTypes(walk, helpers.CheckStereotypeEmbedding, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, embeddingViolations)
+
+	concurrencyViolations, err := helpers.FindConcurrencyFields(walk, helpers.CheckStereotypeConcurrencyField, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, concurrencyViolations)
+
+	errorViolations, err := helpers.FindErrorImplementations(walk, helpers.CheckStereotypeImplementsError, DeclaredName, types, options.ErrorTypes)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, errorViolations)
+
+	mapKeyViolations, err := helpers.FindMapKeyUsagesInWalk(walk, CheckEntityAsMapKey, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, mapKeyViolations)
+
+	mutationViolations, err := findValueObjectMutations(walk, options, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, mutationViolations)
+
+	replacementViolations, err := findValueObjectReplacements(walk, options, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, replacementViolations)
+
+	if options.IsCheckEnabled(CheckPossibleNilValueObject) {
+		nilViolations, err := findPossibleNilValueObjects(walk, options, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, nilViolations)
+	}
+
+	if options.IsCheckEnabled(CheckAnemicEntity) {
+		anemicViolations, err := helpers.FindAnemicTypes(walk, CheckAnemicEntity, DeclaredName, locations)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, anemicViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckConstructorOnlyInTests) {
+		testOnlyViolations, err := helpers.FindTestOnlyConstructors(rootPath, helpers.CheckConstructorOnlyInTests, DeclaredName, locations, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, testOnlyViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckUnusedExportedStereotype) {
+		unusedViolations, err := helpers.FindUnusedExportedTypes(walk, helpers.CheckUnusedExportedStereotype, DeclaredName, locations, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, unusedViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckTrivialConstructor) {
+		trivialViolations, err := helpers.FindTrivialConstructors(walk, helpers.CheckTrivialConstructor, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, trivialViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckConstructorIgnoresError) {
+		ignoredViolations, err := helpers.FindIgnoredErrors(walk, helpers.CheckConstructorIgnoresError, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, ignoredViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckMarkerNotLast) {
+		markerViolations, err := helpers.FindMisplacedMarkers(walk, helpers.CheckMarkerNotLast, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, markerViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckShadowedMarkerName) {
+		shadowViolations, err := helpers.FindShadowedMarkerNames(walk, helpers.CheckShadowedMarkerName, DeclaredName, MarkerField, types, DeclaredName)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, shadowViolations)
+	}
+
+	stopViolationDetection()
+
+	return &ValidateEntitiesReport{
+		Report: *helpers.NewReport(types, constructors, violations, options),
+	}, nil
+}
+
+// valueObjectPointerFields collects the value object types and the entity fields pointing to them.
+func valueObjectPointerFields(walk helpers.Walker, options *helpers.Options, entityTypes map[string]bool) (map[string]bool, map[string][]*helpers.PointerField, error) {
+	isValueObjectTypeDeclaration := options.TypeDeclaration(valueobject.FullPackage, valueobject.MarkerField, valueobject.DeclaredName)
+
+	valueObjectTypes, err := helpers.FindTypeDeclarationsInWalk(walk, isValueObjectTypeDeclaration)
+	if err != nil {
+		return nil, nil, ge.Pin(err)
+	}
+
+	pointerFields, err := helpers.FindPointerFields(walk, entityTypes, valueObjectTypes)
+	if err != nil {
+		return nil, nil, ge.Pin(err)
+	}
+
+	return valueObjectTypes, pointerFields, nil
+}
+
+// findValueObjectMutations detects assignments like `entity.money.amount = x`
+// changing a value object held by an entity through a pointer field.
+func findValueObjectMutations(walk helpers.Walker, options *helpers.Options, entityTypes map[string]bool) (map[string]*helpers.Violation, error) {
+	valueObjectTypes, pointerFields, err := valueObjectPointerFields(walk, options, entityTypes)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	if len(pointerFields) == 0 {
+		return nil, nil
+	}
+
+	valueObjectFields, err := helpers.FindTypeFieldsInWalk(walk, valueObjectTypes, valueobject.MarkerField)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return helpers.FindMutationsThroughPointerFields(walk, CheckValueObjectMutationViaEntity, valueobject.DeclaredName, DeclaredName, pointerFields, valueObjectFields)
+}
+
+// findValueObjectReplacements detects entity methods such as `func (e *Account) Reset() { e.money = &Money{} }`
+// replacing a value object held through a pointer field instead of changing the value the entity holds.
+func findValueObjectReplacements(walk helpers.Walker, options *helpers.Options, entityTypes map[string]bool) (map[string]*helpers.Violation, error) {
+	_, pointerFields, err := valueObjectPointerFields(walk, options, entityTypes)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	if len(pointerFields) == 0 {
+		return nil, nil
+	}
+
+	return helpers.FindPointerFieldReplacements(walk, CheckValueObjectReplaced, valueobject.DeclaredName, DeclaredName, pointerFields)
+}
+
+// findPossibleNilValueObjects detects entity methods such as `func (e *Account) Total() int { return e.money.Amount() }`
+// dereferencing a pointer field to a value object without checking it for nil first.
+func findPossibleNilValueObjects(walk helpers.Walker, options *helpers.Options, entityTypes map[string]bool) (map[string]*helpers.Violation, error) {
+	_, pointerFields, err := valueObjectPointerFields(walk, options, entityTypes)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	if len(pointerFields) == 0 {
+		return nil, nil
+	}
+
+	return helpers.FindUnguardedPointerDereferences(walk, CheckPossibleNilValueObject, DeclaredName, valueobject.DeclaredName, pointerFields)
+}
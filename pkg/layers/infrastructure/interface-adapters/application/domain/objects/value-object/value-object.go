@@ -19,7 +19,7 @@
 //		 projectRoot, err := helpers.FindProjectRoot()
 //		 assert.NoError(t, err)
 //
-//		 report, err := ValidateValueObjects(projectRoot)
+//		 report, err := ValidateValueObjects(projectRoot, helpers.WithMinSeverity(helpers.SeverityError))
 //		 assert.NoError(t, err)
 //
 //		 if report == nil {
@@ -40,11 +40,11 @@
 //			 )
 //		 }
 //
-//		 for violation, _ := range report.Violations {
-//			 t.Logf("VIOLATION: %s", violation)
+//		 for violation, details := range report.Violations {
+//			 t.Logf("[%s] %s", details.Severity, violation)
 //		 }
 //
-//		 assert.Equal(t, 0, len(report.Violations))
+//		 assert.False(t, report.Failed())
 //	}
 package valueobject
 
@@ -63,8 +63,64 @@ const (
 	DeclaredName = "ValueObject"
 	MarkerField  = "_"
 	FullPackage  = "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+
+	// PackageName is the name of the package, which differs from the last element of FullPackage.
+	PackageName = "valueobject"
+
+	// CheckEmptyValueObject flags Value Objects whose only field is the marker.
+	CheckEmptyValueObject = helpers.CheckEmptyValueObject
+
+	// CheckIncompleteConstruction flags constructor returns that leave Value Object fields unset.
+	// It is heuristic and only runs when enabled with helpers.WithEnabledChecks.
+	CheckIncompleteConstruction = helpers.CheckIncompleteConstruction
+
+	// CheckValueObjectFieldTags flags Value Object fields carrying struct tags.
+	// It is advisory and only runs when enabled with helpers.WithEnabledChecks.
+	CheckValueObjectFieldTags = helpers.CheckValueObjectFieldTags
+
+	// CheckNondeterministicValueObject flags Value Object constructors and methods
+	// that read the clock or a random source.
+	// It is heuristic and only runs when enabled with helpers.WithEnabledChecks.
+	CheckNondeterministicValueObject = helpers.CheckNondeterministicValueObject
+
+	// CheckValueObjectStoredAsPointer flags fields and variables holding a pointer
+	// to a Value Object whose constructor returns it by value.
+	// It is heuristic and only runs when enabled with helpers.WithEnabledChecks.
+	CheckValueObjectStoredAsPointer = helpers.CheckValueObjectStoredAsPointer
+
+	// CheckIncompleteEquals flags Equals methods of Value Objects that never reference some of their fields.
+	// It is heuristic and only runs when enabled with helpers.WithEnabledChecks.
+	CheckIncompleteEquals = helpers.CheckIncompleteEquals
+
+	// CheckLargeValueObject flags Value Objects with more data fields than allowed.
+	// It is advisory and only runs when a limit is set with helpers.WithMaxValueObjectFields.
+	CheckLargeValueObject = helpers.CheckLargeValueObject
+
+	// CheckValueObjectPointerStringer flags String methods of Value Objects declared on pointer receivers.
+	// It is advisory and only runs when enabled with helpers.WithEnabledChecks.
+	CheckValueObjectPointerStringer = helpers.CheckValueObjectPointerStringer
+
+	// CheckValueObjectNonValueField flags Value Object fields holding project structs that are not Value Objects.
+	// It is advisory and only runs when enabled with helpers.WithEnabledChecks.
+	CheckValueObjectNonValueField = helpers.CheckValueObjectNonValueField
+
+	// CheckValueObjectGlobalMutation flags Value Object methods assigning package-level variables.
+	// It is advisory and only runs when enabled with helpers.WithEnabledChecks.
+	CheckValueObjectGlobalMutation = helpers.CheckValueObjectGlobalMutation
+
+	// CheckValueObjectAddressTaken flags addresses of Value Object variables passed to functions.
+	// It is advisory and only runs when enabled with helpers.WithEnabledChecks.
+	CheckValueObjectAddressTaken = helpers.CheckValueObjectAddressTaken
+
+	// CheckValueObjectDeepEqual flags Value Objects compared with reflect.DeepEqual outside tests.
+	// It is advisory and only runs when enabled with helpers.WithEnabledChecks.
+	CheckValueObjectDeepEqual = helpers.CheckValueObjectDeepEqual
 )
 
+func init() {
+	helpers.RegisterPackageName(FullPackage, PackageName)
+}
+
 // IsValueObjectTypeDeclaration checks if a struct type contains the ValueObject marker field named "_".
 //
 // Parameters:
@@ -82,14 +138,16 @@ func IsValueObjectTypeDeclaration(file *ast.File, structType *ast.StructType) bo
 // The report provides detailed information about discovered value object types,
 // their constructor functions, and any validation violations found during analysis.
 //
-// Fields:
+// Fields (promoted from helpers.Report):
+//   - SchemaVersion: The version of the report shape, see helpers.ReportSchemaVersion
 //   - Types: Map of discovered value object type names to their validation status
 //   - Constructors: Map of constructor function names to detailed constructor information
-//   - Violations: Map of type names that have validation violations to their violation status
+//   - Files: Map of type names to the files declaring the type, its constructors and methods
+//   - Violations: Map of violation messages to the violation details
+//   - Counts: Number of violations per severity
+//   - MinSeverity: The lowest severity that makes the report fail
 type ValidateValueObjectsReport struct {
-	Types        map[string]bool
-	Constructors map[string]*helpers.ConstructorInfo
-	Violations   map[string]bool
+	helpers.Report
 }
 
 // ValidateValueObjects analyzes Go source code to validate value object patterns.
@@ -100,40 +158,351 @@ type ValidateValueObjectsReport struct {
 //
 // Parameters:
 //   - rootPath: The root directory path to scan for Go source files
+//   - opts: Optional settings such as helpers.WithMinSeverity, overriding the project configuration file
 //
 // Returns:
 //   - *ValidateValueObjectsReport: A detailed report containing found types, constructors, and violations
 //   - error: An error if the validation process fails, nil otherwise
 //
-// The function performs three main steps:
+// The function performs thirty-three main steps:
 //  1. Discovers value object type declarations in the codebase
 //  2. Identifies constructor functions for the discovered types
 //  3. Detects violations where zero values might be incorrectly initialized
+//  4. Detects zero values declared and then filled field by field
+//  5. Detects markers misconfigured as pointers
+//  6. Detects markers used outside the marker field of a struct
+//  7. Detects values created through reflect.New or reflect.Zero
+//  8. Detects types declared in package main
+//  9. Detects stereotype types declared inside function bodies
+//  10. Detects files importing the marker package without any marked type
+//  11. Detects types without any constructor
+//  12. Detects types with only unexported constructors
+//  13. Detects embedded types promoting foreign fields and methods
+//  14. Detects fields holding sync types or channels
+//  15. Detects types implementing the error interface
+//  16. Detects empty value objects that hold nothing but the marker
+//  17. Optionally detects constructor returns that leave fields unset
+//  18. Optionally detects Equals methods not comparing every field
+//  19. Optionally detects value objects with more fields than configured
+//  20. Optionally detects fields carrying struct tags
+//  21. Optionally detects constructors and methods calling time.Now or rand
+//  22. Optionally detects String methods declared on pointer receivers
+//  23. Optionally detects fields holding project structs that are not value objects
+//  24. Optionally detects methods assigning package-level variables
+//  25. Optionally detects addresses of value objects passed to functions
+//  26. Optionally detects value objects compared with reflect.DeepEqual
+//  27. Optionally detects value-constructed types stored as pointers
+//  28. Optionally detects types constructed only in test files
+//  29. Optionally detects exported types never referenced outside their package
+//  30. Optionally detects trivial constructors that only return a zero value
+//  31. Optionally detects constructors discarding errors with the blank identifier
+//  32. Optionally detects marker fields followed by other fields
+//  33. Optionally detects data fields named like the marker
 //
-// Returns nil if no value object types are found in the specified directory.
-func ValidateValueObjects(rootPath string) (*ValidateValueObjectsReport, error) {
-	types, err := helpers.FindTypeDeclarations(rootPath, IsValueObjectTypeDeclaration)
+// Returns nil if no value object types, local declarations and marker misuses included, are found in the specified directory.
+func ValidateValueObjects(rootPath string, opts ...helpers.Option) (*ValidateValueObjectsReport, error) {
+	options, err := helpers.LoadOptions(rootPath, opts...)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	// Projects never importing the marker package have nothing to validate, skip parsing them
+	hasMarkers, err := options.ImportsAnyMarker(rootPath, options.Markers(DeclaredName, FullPackage))
 	if err != nil {
 		return nil, ge.Pin(err)
 	}
 
-	if len(types) == 0 {
+	if !hasMarkers {
 		return nil, nil
 	}
 
-	constructors, err := helpers.FindConstructors(rootPath, types)
+	walk := options.Walker(rootPath)
+	stopTypeDiscovery := options.Metrics.Track(helpers.PhaseTypeDiscovery)
+	isTypeDeclaration := options.TypeDeclaration(FullPackage, MarkerField, DeclaredName)
+
+	types, hookViolations, err := helpers.FindTypeDeclarationsWithChecks(walk, DeclaredName, isTypeDeclaration, options.TypeChecks)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	// Nested value objects are allowed as fields even when the validated types are restricted
+	valueObjects := types
+
+	types = options.FilterTypes(types)
+
+	localViolations, err := helpers.FindLocalTypeDeclarations(walk, helpers.CheckLocalStereotypeDeclaration, DeclaredName, isTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	misuseViolations, err := helpers.FindMarkerMisuses(walk, helpers.CheckMarkerMisuse, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopTypeDiscovery()
+
+	if len(types) == 0 && len(localViolations) == 0 && len(misuseViolations) == 0 {
+		return nil, nil
+	}
+
+	locations, err := helpers.FindTypeLocations(walk, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopConstructorDiscovery := options.Metrics.Track(helpers.PhaseConstructorDiscovery)
+	constructors, err := helpers.FindConstructorsWithFactories(walk, types, options.InterfaceFactories)
+	stopConstructorDiscovery()
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopViolationDetection := options.Metrics.Track(helpers.PhaseViolationDetection)
+
+	violations, err := helpers.FindZeroValueInitializationsWithScope(walk, DeclaredName, types, constructors, options.StrictConstructorScope)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	piecemealViolations, err := helpers.FindPiecemealConstructions(walk, helpers.CheckPiecemealConstruction, DeclaredName, types, constructors)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, piecemealViolations)
+
+	pointerViolations, err := helpers.FindPointerMarkersInWalk(walk, helpers.CheckPointerMarker, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, pointerViolations)
+
+	helpers.MergeViolations(violations, misuseViolations)
+
+	reflectiveViolations, err := helpers.FindReflectiveConstructions(walk, helpers.CheckReflectiveConstruction, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, reflectiveViolations)
+
+	helpers.MergeViolations(violations, helpers.FindStereotypesInMain(helpers.CheckStereotypeInMain, DeclaredName, locations))
+	helpers.MergeViolations(violations, localViolations)
+	helpers.MergeViolations(violations, hookViolations)
+
+	markerImportViolations, err := helpers.FindUnusedMarkerImports(walk, helpers.CheckUnusedMarkerImport, DeclaredName, options.MarkerPackage(DeclaredName, FullPackage), []string{DeclaredName}, isTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, markerImportViolations)
+
+	helpers.MergeViolations(violations, helpers.FindTypesWithoutConstructors(helpers.CheckNoConstructor, DeclaredName, locations, constructors))
+
+	unexportedViolations, err := helpers.FindUnexportedOnlyConstructors(walk, helpers.CheckUnexportedOnlyConstructor, DeclaredName, locations, constructors)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, unexportedViolations)
+
+	embeddingViolations, err := helpers.FindEmbeddedTypes(walk, helpers.CheckStereotypeEmbedding, DeclaredName, types)
 	if err != nil {
 		return nil, ge.Pin(err)
 	}
 
-	violations, err := helpers.FindZeroValueInitializations(rootPath, DeclaredName, types, constructors)
+	helpers.MergeViolations(violations, embeddingViolations)
+
+	concurrencyViolations, err := helpers.FindConcurrencyFields(walk, helpers.CheckStereotypeConcurrencyField, DeclaredName, types)
 	if err != nil {
 		return nil, ge.Pin(err)
 	}
 
+	helpers.MergeViolations(violations, concurrencyViolations)
+
+	errorViolations, err := helpers.FindErrorImplementations(walk, helpers.CheckStereotypeImplementsError, DeclaredName, types, options.ErrorTypes)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, errorViolations)
+
+	emptyViolations, err := helpers.FindEmptyTypeDeclarationsInWalk(walk, CheckEmptyValueObject, DeclaredName, MarkerField, isTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, emptyViolations)
+
+	if options.IsCheckEnabled(CheckIncompleteConstruction) {
+		typeFields, err := helpers.FindTypeFieldsInWalk(walk, types, MarkerField)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		incompleteViolations, err := helpers.FindIncompleteConstructionsInWalk(walk, CheckIncompleteConstruction, DeclaredName, typeFields)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, incompleteViolations)
+	}
+
+	if options.IsCheckEnabled(CheckIncompleteEquals) {
+		typeFields, err := helpers.FindTypeFieldsInWalk(walk, types, MarkerField)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		equalsViolations, err := helpers.FindIncompleteEquals(walk, CheckIncompleteEquals, DeclaredName, typeFields)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, equalsViolations)
+	}
+
+	if options.MaxValueObjectFields > 0 && options.IsCheckEnabled(CheckLargeValueObject) {
+		typeFields, err := helpers.FindTypeFieldsInWalk(walk, types, MarkerField)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, helpers.FindLargeTypes(CheckLargeValueObject, DeclaredName, options.MaxValueObjectFields, locations, typeFields))
+	}
+
+	if options.IsCheckEnabled(CheckValueObjectFieldTags) {
+		tagViolations, err := helpers.FindTaggedFields(walk, CheckValueObjectFieldTags, DeclaredName, MarkerField, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, tagViolations)
+	}
+
+	if options.IsCheckEnabled(CheckNondeterministicValueObject) {
+		nondeterministicViolations, err := helpers.FindNondeterministicCalls(walk, CheckNondeterministicValueObject, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, nondeterministicViolations)
+	}
+
+	if options.IsCheckEnabled(CheckValueObjectPointerStringer) {
+		stringerViolations, err := helpers.FindPointerStringers(walk, CheckValueObjectPointerStringer, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, stringerViolations)
+	}
+
+	if options.IsCheckEnabled(CheckValueObjectNonValueField) {
+		nonValueViolations, err := helpers.FindNonValueFields(walk, CheckValueObjectNonValueField, DeclaredName, MarkerField, types, valueObjects)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, nonValueViolations)
+	}
+
+	if options.IsCheckEnabled(CheckValueObjectGlobalMutation) {
+		globalViolations, err := helpers.FindGlobalMutations(walk, CheckValueObjectGlobalMutation, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, globalViolations)
+	}
+
+	if options.IsCheckEnabled(CheckValueObjectAddressTaken) {
+		addressViolations, err := helpers.FindAddressTakenArguments(walk, CheckValueObjectAddressTaken, DeclaredName, types, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, addressViolations)
+	}
+
+	if options.IsCheckEnabled(CheckValueObjectDeepEqual) {
+		deepEqualViolations, err := helpers.FindDeepEqualComparisons(walk, CheckValueObjectDeepEqual, DeclaredName, types, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, deepEqualViolations)
+	}
+
+	if options.IsCheckEnabled(CheckValueObjectStoredAsPointer) {
+		storageViolations, err := helpers.FindPointerStorage(walk, CheckValueObjectStoredAsPointer, DeclaredName, helpers.ValueConstructedTypes(constructors))
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, storageViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckConstructorOnlyInTests) {
+		testOnlyViolations, err := helpers.FindTestOnlyConstructors(rootPath, helpers.CheckConstructorOnlyInTests, DeclaredName, locations, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, testOnlyViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckUnusedExportedStereotype) {
+		unusedViolations, err := helpers.FindUnusedExportedTypes(walk, helpers.CheckUnusedExportedStereotype, DeclaredName, locations, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, unusedViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckTrivialConstructor) {
+		trivialViolations, err := helpers.FindTrivialConstructors(walk, helpers.CheckTrivialConstructor, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, trivialViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckConstructorIgnoresError) {
+		ignoredViolations, err := helpers.FindIgnoredErrors(walk, helpers.CheckConstructorIgnoresError, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, ignoredViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckMarkerNotLast) {
+		markerViolations, err := helpers.FindMisplacedMarkers(walk, helpers.CheckMarkerNotLast, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, markerViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckShadowedMarkerName) {
+		shadowViolations, err := helpers.FindShadowedMarkerNames(walk, helpers.CheckShadowedMarkerName, DeclaredName, MarkerField, types, DeclaredName)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, shadowViolations)
+	}
+
+	stopViolationDetection()
+
 	return &ValidateValueObjectsReport{
-		Types:        types,
-		Constructors: constructors,
-		Violations:   violations,
+		Report: *helpers.NewReport(types, constructors, violations, options),
 	}, nil
 }
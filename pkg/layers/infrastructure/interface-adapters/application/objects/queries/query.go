@@ -34,14 +34,16 @@ func IsQueryTypeDeclaration(file *ast.File, structType *ast.StructType) bool {
 // The report provides detailed information about discovered value object types,
 // their constructor functions, and any validation violations found during analysis.
 //
-// Fields:
+// Fields (promoted from helpers.Report):
+//   - SchemaVersion: The version of the report shape, see helpers.ReportSchemaVersion
 //   - Types: Map of discovered value object type names to their validation status
 //   - Constructors: Map of constructor function names to detailed constructor information
-//   - Violations: Map of type names that have validation violations to their violation status
+//   - Files: Map of type names to the files declaring the type, its constructors and methods
+//   - Violations: Map of violation messages to the violation details
+//   - Counts: Number of violations per severity
+//   - MinSeverity: The lowest severity that makes the report fail
 type ValidateQueriesReport struct {
-	Types        map[string]bool
-	Constructors map[string]*helpers.ConstructorInfo
-	Violations   map[string]bool
+	helpers.Report
 }
 
 // ValidateQueries analyzes Go source code to validate value object patterns.
@@ -52,40 +54,220 @@ type ValidateQueriesReport struct {
 //
 // Parameters:
 //   - rootPath: The root directory path to scan for Go source files
+//   - opts: Optional settings such as helpers.WithMinSeverity, overriding the project configuration file
 //
 // Returns:
 //   - *ValidateQueriesReport: A detailed report containing found types, constructors, and violations
 //   - error: An error if the validation process fails, nil otherwise
 //
-// The function performs three main steps:
+// The function performs twenty-one main steps:
 //  1. Discovers value object type declarations in the codebase
 //  2. Identifies constructor functions for the discovered types
 //  3. Detects violations where zero values might be incorrectly initialized
+//  4. Detects zero values declared and then filled field by field
+//  5. Detects markers misconfigured as pointers
+//  6. Detects markers used outside the marker field of a struct
+//  7. Detects values created through reflect.New or reflect.Zero
+//  8. Detects types declared in package main
+//  9. Detects stereotype types declared inside function bodies
+//  10. Detects files importing the marker package without any marked type
+//  11. Detects types without any constructor
+//  12. Detects types with only unexported constructors
+//  13. Detects embedded types promoting foreign fields and methods
+//  14. Detects fields holding sync types or channels
+//  15. Detects types implementing the error interface
+//  16. Optionally detects types constructed only in test files
+//  17. Optionally detects exported types never referenced outside their package
+//  18. Optionally detects trivial constructors that only return a zero value
+//  19. Optionally detects constructors discarding errors with the blank identifier
+//  20. Optionally detects marker fields followed by other fields
+//  21. Optionally detects data fields named like the marker
 //
-// Returns nil if no value object types are found in the specified directory.
-func ValidateQueries(rootPath string) (*ValidateQueriesReport, error) {
-	types, err := helpers.FindTypeDeclarations(rootPath, IsQueryTypeDeclaration)
+// Returns nil if no value object types, local declarations and marker misuses included, are found in the specified directory.
+func ValidateQueries(rootPath string, opts ...helpers.Option) (*ValidateQueriesReport, error) {
+	options, err := helpers.LoadOptions(rootPath, opts...)
 	if err != nil {
 		return nil, ge.Pin(err)
 	}
 
-	if len(types) == 0 {
+	// Projects never importing the marker package have nothing to validate, skip parsing them
+	hasMarkers, err := options.ImportsAnyMarker(rootPath, options.Markers(DeclaredName, FullPackage))
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	if !hasMarkers {
 		return nil, nil
 	}
 
-	constructors, err := helpers.FindConstructors(rootPath, types)
+	walk := options.Walker(rootPath)
+	stopTypeDiscovery := options.Metrics.Track(helpers.PhaseTypeDiscovery)
+	isTypeDeclaration := options.TypeDeclaration(FullPackage, MarkerField, DeclaredName)
+
+	types, hookViolations, err := helpers.FindTypeDeclarationsWithChecks(walk, DeclaredName, isTypeDeclaration, options.TypeChecks)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	types = options.FilterTypes(types)
+
+	localViolations, err := helpers.FindLocalTypeDeclarations(walk, helpers.CheckLocalStereotypeDeclaration, DeclaredName, isTypeDeclaration)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	misuseViolations, err := helpers.FindMarkerMisuses(walk, helpers.CheckMarkerMisuse, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopTypeDiscovery()
+
+	if len(types) == 0 && len(localViolations) == 0 && len(misuseViolations) == 0 {
+		return nil, nil
+	}
+
+	locations, err := helpers.FindTypeLocations(walk, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopConstructorDiscovery := options.Metrics.Track(helpers.PhaseConstructorDiscovery)
+	constructors, err := helpers.FindConstructorsWithFactories(walk, types, options.InterfaceFactories)
+	stopConstructorDiscovery()
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopViolationDetection := options.Metrics.Track(helpers.PhaseViolationDetection)
+
+	violations, err := helpers.FindZeroValueInitializationsWithScope(walk, DeclaredName, types, constructors, options.StrictConstructorScope)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	piecemealViolations, err := helpers.FindPiecemealConstructions(walk, helpers.CheckPiecemealConstruction, DeclaredName, types, constructors)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, piecemealViolations)
+
+	pointerViolations, err := helpers.FindPointerMarkersInWalk(walk, helpers.CheckPointerMarker, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, pointerViolations)
+
+	helpers.MergeViolations(violations, misuseViolations)
+
+	reflectiveViolations, err := helpers.FindReflectiveConstructions(walk, helpers.CheckReflectiveConstruction, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, reflectiveViolations)
+
+	helpers.MergeViolations(violations, helpers.FindStereotypesInMain(helpers.CheckStereotypeInMain, DeclaredName, locations))
+	helpers.MergeViolations(violations, localViolations)
+	helpers.MergeViolations(violations, hookViolations)
+
+	markerImportViolations, err := helpers.FindUnusedMarkerImports(walk, helpers.CheckUnusedMarkerImport, DeclaredName, options.MarkerPackage(DeclaredName, FullPackage), []string{DeclaredName}, isTypeDeclaration)
 	if err != nil {
 		return nil, ge.Pin(err)
 	}
 
-	violations, err := helpers.FindZeroValueInitializations(rootPath, DeclaredName, types, constructors)
+	helpers.MergeViolations(violations, markerImportViolations)
+
+	helpers.MergeViolations(violations, helpers.FindTypesWithoutConstructors(helpers.CheckNoConstructor, DeclaredName, locations, constructors))
+
+	unexportedViolations, err := helpers.FindUnexportedOnlyConstructors(walk, helpers.CheckUnexportedOnlyConstructor, DeclaredName, locations, constructors)
 	if err != nil {
 		return nil, ge.Pin(err)
 	}
 
+	helpers.MergeViolations(violations, unexportedViolations)
+
+	embeddingViolations, err := helpers.FindEmbeddedTypes(walk, helpers.CheckStereotypeEmbedding, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, embeddingViolations)
+
+	concurrencyViolations, err := helpers.FindConcurrencyFields(walk, helpers.CheckStereotypeConcurrencyField, DeclaredName, types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, concurrencyViolations)
+
+	errorViolations, err := helpers.FindErrorImplementations(walk, helpers.CheckStereotypeImplementsError, DeclaredName, types, options.ErrorTypes)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	helpers.MergeViolations(violations, errorViolations)
+
+	if options.IsCheckEnabled(helpers.CheckConstructorOnlyInTests) {
+		testOnlyViolations, err := helpers.FindTestOnlyConstructors(rootPath, helpers.CheckConstructorOnlyInTests, DeclaredName, locations, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, testOnlyViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckUnusedExportedStereotype) {
+		unusedViolations, err := helpers.FindUnusedExportedTypes(walk, helpers.CheckUnusedExportedStereotype, DeclaredName, locations, constructors)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, unusedViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckTrivialConstructor) {
+		trivialViolations, err := helpers.FindTrivialConstructors(walk, helpers.CheckTrivialConstructor, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, trivialViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckConstructorIgnoresError) {
+		ignoredViolations, err := helpers.FindIgnoredErrors(walk, helpers.CheckConstructorIgnoresError, DeclaredName, types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, ignoredViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckMarkerNotLast) {
+		markerViolations, err := helpers.FindMisplacedMarkers(walk, helpers.CheckMarkerNotLast, options.MarkerPackage(DeclaredName, FullPackage), MarkerField, DeclaredName)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, markerViolations)
+	}
+
+	if options.IsCheckEnabled(helpers.CheckShadowedMarkerName) {
+		shadowViolations, err := helpers.FindShadowedMarkerNames(walk, helpers.CheckShadowedMarkerName, DeclaredName, MarkerField, types, DeclaredName)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		helpers.MergeViolations(violations, shadowViolations)
+	}
+
+	stopViolationDetection()
+
 	return &ValidateQueriesReport{
-		Types:        types,
-		Constructors: constructors,
-		Violations:   violations,
+		Report: *helpers.NewReport(types, constructors, violations, options),
 	}, nil
 }
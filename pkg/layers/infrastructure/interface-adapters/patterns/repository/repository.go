@@ -0,0 +1,9 @@
+package repository
+
+type Repository struct{}
+
+const (
+	DeclaredName = "Repository"
+	MarkerField  = "_"
+	FullPackage  = "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/patterns/repository"
+)
@@ -0,0 +1,145 @@
+// Package layers validates how the packages of a project are organised into DDD layers.
+package layers
+
+import (
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	"github.com/nobuenhombre/dddgo/pkg/validator"
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+const (
+	// CheckUnmarkedDomainStruct flags exported structs in domain packages without any stereotype marker.
+	CheckUnmarkedDomainStruct = helpers.CheckUnmarkedDomainStruct
+
+	// CheckDomainTypeSerializedAtBoundary flags stereotype types serialized with encoding/json outside the domain layer.
+	CheckDomainTypeSerializedAtBoundary = helpers.CheckDomainTypeSerializedAtBoundary
+
+	domainObjectsPackage  = "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/"
+	domainServicesPackage = "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/services/"
+)
+
+// LayerConfig classifies the packages of a project into layers.
+// An empty LayerConfig falls back to the layers of the project configuration file, see helpers.WithDomainPackages.
+//
+// Fields:
+//   - Domain: Package patterns such as "./internal/domain/..." of the domain layer, an empty list covers every package
+type LayerConfig struct {
+	Domain []string
+}
+
+// domainPackages returns the domain package patterns of layerConfig, or the configured ones when it has none.
+func (c LayerConfig) domainPackages(options *helpers.Options) []string {
+	if len(c.Domain) == 0 {
+		return options.DomainPackages
+	}
+
+	return c.Domain
+}
+
+// domainMarkers lists the domain markers without a validator of their own, counted as stereotypes for coverage.
+var domainMarkers = []validator.Stereotype{
+	{FullPackage: domainObjectsPackage + "domain-event", PackageName: "domainevent", DeclaredName: "DomainEvent", MarkerField: "_"},
+	{FullPackage: domainObjectsPackage + "domain-primitive", PackageName: "domainprimitive", DeclaredName: "DomainPrimitive", MarkerField: "_"},
+	{FullPackage: domainServicesPackage + "domain-service", PackageName: "domainservice", DeclaredName: "DomainService", MarkerField: "_"},
+}
+
+func init() {
+	for _, marker := range domainMarkers {
+		helpers.RegisterPackageName(marker.FullPackage, marker.PackageName)
+	}
+}
+
+// ValidateDomainCoverage requires every exported struct of the domain layer to carry a stereotype marker,
+// either one of the registered stereotypes, see validator.RegisterStereotype, or a DomainEvent,
+// DomainPrimitive or DomainService marker. It is the strictest mode and meant for greenfield services.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - layerConfig: The layer classification of the project's packages, an empty one uses the configured layers
+//   - opts: Optional settings such as helpers.WithMinSeverity, overriding the project configuration file
+//
+// Returns:
+//   - *helpers.Report: The report, its Types map every inspected struct to whether it is marked
+//   - error: An error if the validation process fails, nil otherwise
+func ValidateDomainCoverage(rootPath string, layerConfig LayerConfig, opts ...helpers.Option) (*helpers.Report, error) {
+	options, err := helpers.LoadOptions(rootPath, opts...)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	walk := options.Walker(rootPath)
+	stereotypes := append(validator.RegisteredStereotypes(), domainMarkers...)
+	isTypeDeclarations := make([]helpers.IsTypeDeclaration, 0, len(stereotypes))
+
+	for _, stereotype := range stereotypes {
+		isTypeDeclarations = append(isTypeDeclarations, options.TypeDeclaration(stereotype.FullPackage, stereotype.MarkerField, stereotype.DeclaredName, stereotype.AlternativeMarkers...))
+	}
+
+	stopViolationDetection := options.Metrics.Track(helpers.PhaseViolationDetection)
+	types, violations, err := helpers.FindUnmarkedStructs(walk, CheckUnmarkedDomainStruct, options.RootPath, layerConfig.domainPackages(options), isTypeDeclarations)
+	stopViolationDetection()
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return helpers.NewReport(types, nil, violations, options), nil
+}
+
+// ValidateBoundarySerialization requires the packages outside the domain layer to map stereotype types to DTOs
+// before serializing them: calls such as json.Marshal(order), json.Unmarshal(data, &order)
+// or json.NewEncoder(w).Encode(order) on a stereotype type are reported.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - layerConfig: The layer classification of the project's packages, an empty one uses the configured layers,
+//     without any Domain patterns nothing is outside the domain layer
+//   - opts: Optional settings such as helpers.WithMinSeverity, overriding the project configuration file
+//
+// Returns:
+//   - *helpers.Report: The report, its Types list the stereotype types of every registered stereotype and domain marker
+//   - error: An error if the validation process fails, nil otherwise
+func ValidateBoundarySerialization(rootPath string, layerConfig LayerConfig, opts ...helpers.Option) (*helpers.Report, error) {
+	options, err := helpers.LoadOptions(rootPath, opts...)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	walk := options.Walker(rootPath)
+	types := make(map[string]bool)
+
+	stopTypeDiscovery := options.Metrics.Track(helpers.PhaseTypeDiscovery)
+
+	for _, stereotype := range append(validator.RegisteredStereotypes(), domainMarkers...) {
+		isTypeDeclaration := options.TypeDeclaration(stereotype.FullPackage, stereotype.MarkerField, stereotype.DeclaredName, stereotype.AlternativeMarkers...)
+
+		stereotypeTypes, err := helpers.FindTypeDeclarationsInWalk(walk, isTypeDeclaration)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		for typeName := range options.FilterTypes(stereotypeTypes) {
+			types[typeName] = true
+		}
+	}
+
+	stopTypeDiscovery()
+
+	stopConstructorDiscovery := options.Metrics.Track(helpers.PhaseConstructorDiscovery)
+	constructors, err := helpers.FindConstructorsWithFactories(walk, types, options.InterfaceFactories)
+	stopConstructorDiscovery()
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopViolationDetection := options.Metrics.Track(helpers.PhaseViolationDetection)
+	violations, err := helpers.FindBoundarySerializations(walk, CheckDomainTypeSerializedAtBoundary, options.RootPath, layerConfig.domainPackages(options), types, constructors)
+	stopViolationDetection()
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	return helpers.NewReport(types, constructors, violations, options), nil
+}
@@ -0,0 +1,46 @@
+package reporter
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// jsonDocument is the root object of a JSON report.
+type jsonDocument struct {
+	SchemaVersion string                     `json:"schemaVersion"`
+	Reports       map[string]*helpers.Report `json:"reports"`
+}
+
+// WriteJSON renders reports as an indented JSON document.
+//
+// The document carries helpers.ReportSchemaVersion at the top level and in every report,
+// so consumers can detect shape changes before decoding the reports.
+//
+// Parameters:
+//   - w: The writer receiving the JSON document
+//   - reports: Reports keyed by stereotype marker name
+//
+// Returns:
+//   - An error if encoding or writing fails, nil otherwise
+func WriteJSON(w io.Writer, reports map[string]*helpers.Report) error {
+	document := jsonDocument{
+		SchemaVersion: helpers.ReportSchemaVersion,
+		Reports:       reports,
+	}
+
+	if document.Reports == nil {
+		document.Reports = make(map[string]*helpers.Report)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return ge.Pin(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package reporter
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// Kinds of the JSON Lines records.
+const (
+	jsonLinesViolation = "violation"
+	jsonLinesSummary   = "summary"
+)
+
+// jsonLinesViolationRecord is the line of a single violation.
+type jsonLinesViolationRecord struct {
+	Kind       string `json:"kind"`
+	Stereotype string `json:"stereotype"`
+	helpers.Violation
+}
+
+// jsonLinesSummaryRecord is the closing line totalling the violations written before it.
+type jsonLinesSummaryRecord struct {
+	Kind          string                   `json:"kind"`
+	SchemaVersion string                   `json:"schemaVersion"`
+	Violations    int                      `json:"violations"`
+	Counts        map[helpers.Severity]int `json:"counts"`
+	Failed        bool                     `json:"failed"`
+}
+
+// JSONLinesWriter writes violations as JSON Lines, one compact JSON object per line, as they come,
+// so neither the producer nor a log pipeline ingesting the output has to hold a whole document.
+// Every line carries a "kind" of "violation" or "summary", Close writes the summary line.
+type JSONLinesWriter struct {
+	encoder *json.Encoder
+	summary jsonLinesSummaryRecord
+}
+
+// NewJSONLinesWriter returns a JSONLinesWriter writing to w.
+//
+// Parameters:
+//   - w: The writer receiving the lines
+//
+// Returns:
+//   - The JSON Lines writer
+func NewJSONLinesWriter(w io.Writer) *JSONLinesWriter {
+	return &JSONLinesWriter{
+		encoder: json.NewEncoder(w),
+		summary: jsonLinesSummaryRecord{
+			Kind:          jsonLinesSummary,
+			SchemaVersion: helpers.ReportSchemaVersion,
+			Counts:        make(map[helpers.Severity]int),
+		},
+	}
+}
+
+// WriteViolation writes the line of a violation and adds it to the summary.
+//
+// Parameters:
+//   - stereotype: The stereotype marker name the violation was reported for
+//   - violation: The violation
+//
+// Returns:
+//   - An error if encoding or writing fails, nil otherwise
+func (j *JSONLinesWriter) WriteViolation(stereotype string, violation *helpers.Violation) error {
+	record := jsonLinesViolationRecord{
+		Kind:       jsonLinesViolation,
+		Stereotype: stereotype,
+		Violation:  *violation,
+	}
+
+	if err := j.encoder.Encode(record); err != nil {
+		return ge.Pin(err)
+	}
+
+	j.summary.Violations++
+	j.summary.Counts[violation.Severity]++
+
+	return nil
+}
+
+// Close writes the summary line.
+//
+// Parameters:
+//   - failed: Whether the run failed, e.g. helpers.Report.Failed of any report
+//
+// Returns:
+//   - An error if encoding or writing fails, nil otherwise
+func (j *JSONLinesWriter) Close(failed bool) error {
+	j.summary.Failed = failed
+
+	if err := j.encoder.Encode(j.summary); err != nil {
+		return ge.Pin(err)
+	}
+
+	return nil
+}
+
+// WriteJSONLines renders reports as JSON Lines, see JSONLinesWriter: one line per violation,
+// sorted by stereotype, file and line, and a final summary line.
+// A violation reported for several stereotypes is written once per stereotype.
+//
+// Parameters:
+//   - w: The writer receiving the lines
+//   - reports: Reports keyed by stereotype marker name
+//
+// Returns:
+//   - An error if encoding or writing fails, nil otherwise
+func WriteJSONLines(w io.Writer, reports map[string]*helpers.Report) error {
+	names := make([]string, 0, len(reports))
+	for name := range reports {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	lines := NewJSONLinesWriter(w)
+	failed := false
+
+	for _, name := range names {
+		report := reports[name]
+		failed = failed || report.Failed()
+
+		byFile := report.ViolationsByFile()
+
+		files := make([]string, 0, len(byFile))
+		for file := range byFile {
+			files = append(files, file)
+		}
+
+		sort.Strings(files)
+
+		for _, file := range files {
+			for _, violation := range byFile[file] {
+				if err := lines.WriteViolation(name, &violation); err != nil {
+					return ge.Pin(err)
+				}
+			}
+		}
+	}
+
+	if err := lines.Close(failed); err != nil {
+		return ge.Pin(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+// Package reporter renders validation reports in formats consumed by other tools.
+package reporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// junitTestSuites is the root element of a JUnit XML document.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite groups the test cases of one stereotype.
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase is a single passing or failing check result.
+// Passing cases of advisory violations carry them in SystemOut.
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// junitFailure describes why a test case failed.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit renders reports as JUnit XML.
+//
+// Every stereotype becomes a test suite named "dddgo.<Stereotype>".
+// A type without violations produces one passing test case named after the type,
+// a type with violations produces one test case per check, named
+// "<type>/<check>", listing every file:line occurrence. The case fails if any of its violations
+// is at or above the report's MinSeverity, otherwise it passes with the occurrences in its system-out,
+// so advisories stay visible without turning CI red. Names do not contain
+// line numbers, so they stay stable for CI history tracking.
+//
+// Parameters:
+//   - w: The writer receiving the XML document
+//   - reports: Reports keyed by stereotype marker name, nil reports produce empty suites
+//
+// Returns:
+//   - An error if writing fails, nil otherwise
+func WriteJUnit(w io.Writer, reports map[string]*helpers.Report) error {
+	document := junitTestSuites{}
+
+	stereotypes := make([]string, 0, len(reports))
+	for stereotype := range reports {
+		stereotypes = append(stereotypes, stereotype)
+	}
+
+	sort.Strings(stereotypes)
+
+	for _, stereotype := range stereotypes {
+		suite := newJUnitTestSuite(stereotype, reports[stereotype])
+
+		document.Tests += suite.Tests
+		document.Failures += suite.Failures
+		document.Suites = append(document.Suites, suite)
+	}
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return ge.Pin(err)
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	err = encoder.Encode(document)
+	if err != nil {
+		return ge.Pin(err)
+	}
+
+	_, err = io.WriteString(w, "\n")
+	if err != nil {
+		return ge.Pin(err)
+	}
+
+	return nil
+}
+
+// newJUnitTestSuite builds the test suite of one stereotype.
+func newJUnitTestSuite(stereotype string, report *helpers.Report) junitTestSuite {
+	suite := junitTestSuite{
+		Name: "dddgo." + stereotype,
+	}
+
+	if report == nil {
+		return suite
+	}
+
+	// type -> check -> violations
+	byType := make(map[string]map[string][]*helpers.Violation)
+
+	for _, violation := range report.Violations {
+		if byType[violation.TypeName] == nil {
+			byType[violation.TypeName] = make(map[string][]*helpers.Violation)
+		}
+
+		byType[violation.TypeName][violation.Check] = append(byType[violation.TypeName][violation.Check], violation)
+	}
+
+	typeNames := make([]string, 0, len(report.Types)+len(byType))
+	for typeName := range report.Types {
+		typeNames = append(typeNames, typeName)
+	}
+
+	for typeName := range byType {
+		if !report.Types[typeName] {
+			typeNames = append(typeNames, typeName)
+		}
+	}
+
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		checks := byType[typeName]
+		if len(checks) == 0 {
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      typeName,
+				ClassName: suite.Name,
+			})
+
+			continue
+		}
+
+		checkNames := make([]string, 0, len(checks))
+		for check := range checks {
+			checkNames = append(checkNames, check)
+		}
+
+		sort.Strings(checkNames)
+
+		for _, check := range checkNames {
+			testCase := newJUnitCheckCase(suite.Name, typeName, check, checks[check], report.MinSeverity)
+			if testCase.Failure != nil {
+				suite.Failures++
+			}
+
+			suite.Cases = append(suite.Cases, testCase)
+		}
+	}
+
+	suite.Tests = len(suite.Cases)
+
+	return suite
+}
+
+// newJUnitCheckCase builds the test case of one check on one type,
+// failing if any of the violations is at or above minSeverity.
+func newJUnitCheckCase(className string, typeName string, check string, violations []*helpers.Violation, minSeverity helpers.Severity) junitTestCase {
+	sort.Slice(violations, func(i, j int) bool {
+		if violations[i].File != violations[j].File {
+			return violations[i].File < violations[j].File
+		}
+
+		return violations[i].Line < violations[j].Line
+	})
+
+	lines := make([]string, 0, len(violations))
+	severity := violations[0].Severity
+
+	for _, violation := range violations {
+		lines = append(lines, violation.Message)
+
+		if violation.Severity > severity {
+			severity = violation.Severity
+		}
+	}
+
+	testCase := junitTestCase{
+		Name:      typeName + "/" + check,
+		ClassName: className,
+	}
+
+	if severity < minSeverity {
+		testCase.SystemOut = strings.Join(lines, "\n")
+
+		return testCase
+	}
+
+	first := violations[0]
+
+	testCase.Failure = &junitFailure{
+		Message: fmt.Sprintf("%d %s violation(s), first at %s:%d", len(violations), check, first.File, first.Line),
+		Type:    severity.String(),
+		Text:    strings.Join(lines, "\n"),
+	}
+
+	return testCase
+}
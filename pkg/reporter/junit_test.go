@@ -0,0 +1,44 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+)
+
+func TestWriteJUnitPassesViolationsBelowMinSeverity(t *testing.T) {
+	report := &helpers.Report{
+		Types: map[string]bool{"shop.Money": true},
+		Violations: map[string]*helpers.Violation{
+			"advisory": {Check: "advisory", Severity: helpers.SeverityWarning, TypeName: "shop.Money", File: "shop/money.go", Line: 3, Message: "advisory"},
+			"blocking": {Check: "blocking", Severity: helpers.SeverityError, TypeName: "shop.Money", File: "shop/money.go", Line: 5, Message: "blocking"},
+		},
+		MinSeverity: helpers.SeverityError,
+	}
+
+	var buf bytes.Buffer
+	if err := WriteJUnit(&buf, map[string]*helpers.Report{"ValueObject": report}); err != nil {
+		t.Fatalf("WriteJUnit() error = %v", err)
+	}
+
+	var document junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &document); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+
+	if document.Tests != 2 || document.Failures != 1 {
+		t.Fatalf("tests = %d, failures = %d, want 2 and 1", document.Tests, document.Failures)
+	}
+
+	cases := document.Suites[0].Cases
+
+	if advisory := cases[0]; advisory.Failure != nil || advisory.SystemOut != "advisory" {
+		t.Errorf("advisory case = %+v, want passing with the violation in system-out", advisory)
+	}
+
+	if blocking := cases[1]; blocking.Failure == nil || blocking.Failure.Type != helpers.SeverityError.String() {
+		t.Errorf("blocking case = %+v, want an error failure", blocking)
+	}
+}
@@ -0,0 +1,53 @@
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// WriteSummary renders one summary line per stereotype, sorted by stereotype name, and a grand total,
+// the short status printed to CI consoles while the full report goes to an artifact.
+//
+// Parameters:
+//   - w: The writer receiving the summary
+//   - reports: Reports keyed by stereotype marker name
+//
+// Returns:
+//   - An error if writing fails, nil otherwise
+func WriteSummary(w io.Writer, reports map[string]*helpers.Report) error {
+	names := make([]string, 0, len(reports))
+	for name := range reports {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	total := &helpers.Report{
+		Violations: make(map[string]*helpers.Violation),
+		Counts:     make(map[helpers.Severity]int),
+	}
+
+	for _, name := range names {
+		report := reports[name]
+
+		if _, err := fmt.Fprintf(w, "%s: %s\n", name, report.SummaryLine()); err != nil {
+			return ge.Pin(err)
+		}
+
+		helpers.MergeViolations(total.Violations, report.Violations)
+
+		for severity, count := range report.Counts {
+			total.Counts[severity] += count
+		}
+	}
+
+	if _, err := fmt.Fprintf(w, "Total: %s\n", total.SummaryLine()); err != nil {
+		return ge.Pin(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package validator
+
+import (
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"sort"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// StereotypeType is a type marked with a registered stereotype.
+//
+// Fields:
+//   - Kind: The name of the stereotype, e.g. "ValueObject"
+//   - Package: The import path of the declaring package, or its name when no go.mod file is found
+//   - Name: The type name
+//   - File: The path of the declaring file, relative to helpers.WithRelativeTo or the scanned root
+//   - Line: The line of the type declaration
+type StereotypeType struct {
+	Kind    string `json:"kind"`
+	Package string `json:"package"`
+	Name    string `json:"name"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+}
+
+// DiscoverStereotypes lists the types of every registered stereotype in a single discovery pass,
+// without looking for constructors or violations, for tools such as outlines or architecture docs
+// that only need to know which types carry which marker. A type carrying several markers is listed once per stereotype.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - opts: Optional settings such as helpers.WithMarkerPackage
+//
+// Returns:
+//   - []StereotypeType: The marked types sorted by file, line and kind
+//   - error: An error if the scan fails, nil otherwise
+func DiscoverStereotypes(rootPath string, opts ...helpers.Option) ([]StereotypeType, error) {
+	options, err := helpers.LoadOptions(rootPath, opts...)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stereotypes := RegisteredStereotypes()
+	isTypeDeclarations := make([]helpers.IsTypeDeclaration, len(stereotypes))
+
+	for i, stereotype := range stereotypes {
+		isTypeDeclarations[i] = options.TypeDeclaration(stereotype.FullPackage, stereotype.MarkerField, stereotype.DeclaredName, stereotype.AlternativeMarkers...)
+	}
+
+	var found []StereotypeType
+
+	importPaths := make(map[string]string)
+
+	err = options.Walker(rootPath)(func(path string, fileSet *token.FileSet, file *ast.File) {
+		dir := filepath.Dir(path)
+
+		importPath, ok := importPaths[dir]
+		if !ok {
+			if importPath, ok = helpers.ModuleImportPath(dir); !ok {
+				importPath = file.Name.Name
+			}
+
+			importPaths[dir] = importPath
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			structType, ok := ast.Unparen(typeSpec.Type).(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			for i, isTypeDeclaration := range isTypeDeclarations {
+				if !isTypeDeclaration(file, structType) {
+					continue
+				}
+
+				found = append(found, StereotypeType{
+					Kind:    stereotypes[i].Name,
+					Package: importPath,
+					Name:    typeSpec.Name.Name,
+					File:    filepath.ToSlash(helpers.RelativePath(options.RelativeTo, path)),
+					Line:    fileSet.Position(typeSpec.Pos()).Line,
+				})
+			}
+
+			return true
+		})
+	})
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].File != found[j].File {
+			return found[i].File < found[j].File
+		}
+
+		if found[i].Line != found[j].Line {
+			return found[i].Line < found[j].Line
+		}
+
+		return found[i].Kind < found[j].Kind
+	})
+
+	return found, nil
+}
@@ -0,0 +1,16 @@
+package validator
+
+import (
+	"errors"
+)
+
+var (
+	// ErrInvalidStereotype is returned when a stereotype definition lacks a required field.
+	ErrInvalidStereotype = errors.New("invalid stereotype")
+
+	// ErrStereotypeExists is returned when a stereotype with the same name is already registered.
+	ErrStereotypeExists = errors.New("stereotype already registered")
+
+	// ErrUnknownCheck is returned when a stereotype refers to a check that cannot be run generically.
+	ErrUnknownCheck = errors.New("unknown check")
+)
@@ -0,0 +1,699 @@
+package validator
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/aggregate"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/entity"
+	valueobject "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/objects/commands"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/objects/queries"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/patterns/repository"
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// Stereotype describes a marker based stereotype validated by ValidateRegistered.
+//
+// Fields:
+//   - Name: The unique stereotype name reports are keyed by, e.g. "DomainEvent"
+//   - FullPackage: The full import path of the package declaring the marker type
+//   - PackageName: The name of that package when it differs from the last element of FullPackage, see helpers.RegisterPackageName
+//   - DeclaredName: The marker type name, e.g. "DomainEvent"
+//   - MarkerField: The name of the marker field, usually "_"
+//   - Checks: The check identifiers run for the stereotype, nil runs DefaultChecks
+//   - AlternativeMarkers: Further markers types are recognised by, e.g. the previous marker package during a migration
+type Stereotype struct {
+	Name               string
+	FullPackage        string
+	PackageName        string
+	DeclaredName       string
+	MarkerField        string
+	Checks             []string
+	AlternativeMarkers []helpers.Marker
+}
+
+// DefaultChecks are the checks run for a registered stereotype that does not list its own.
+var DefaultChecks = []string{
+	helpers.CheckZeroValueInitialization,
+	helpers.CheckPiecemealConstruction,
+	helpers.CheckPointerMarker,
+	helpers.CheckMarkerMisuse,
+	helpers.CheckReflectiveConstruction,
+	helpers.CheckStereotypeInMain,
+	helpers.CheckLocalStereotypeDeclaration,
+	helpers.CheckNoConstructor,
+	helpers.CheckUnexportedOnlyConstructor,
+	helpers.CheckStereotypeEmbedding,
+	helpers.CheckStereotypeConcurrencyField,
+	helpers.CheckStereotypeImplementsError,
+	helpers.CheckConstructorOnlyInTests,
+	helpers.CheckUnusedExportedStereotype,
+	helpers.CheckTrivialConstructor,
+	helpers.CheckConstructorIgnoresError,
+	helpers.CheckMarkerNotLast,
+	helpers.CheckShadowedMarkerName,
+	helpers.CheckUnusedMarkerImport,
+}
+
+// stereotypeScan holds everything discovered about a stereotype that checks work on.
+type stereotypeScan struct {
+	walk              helpers.Walker
+	options           *helpers.Options
+	stereotype        Stereotype
+	isTypeDeclaration helpers.IsTypeDeclaration
+	types             map[string]bool
+	locations         map[string]*helpers.TypeLocation
+	constructors      map[string]*helpers.ConstructorInfo
+}
+
+// checkRunner runs a single check over a stereotype scan.
+type checkRunner func(scan *stereotypeScan) (map[string]*helpers.Violation, error)
+
+// checkRunners lists the checks a registered stereotype can use by their identifiers.
+var checkRunners = map[string]checkRunner{
+	helpers.CheckZeroValueInitialization: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindZeroValueInitializationsWithScope(scan.walk, scan.stereotype.DeclaredName, scan.types, scan.constructors, scan.options.StrictConstructorScope)
+	},
+	helpers.CheckPiecemealConstruction: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindPiecemealConstructions(scan.walk, helpers.CheckPiecemealConstruction, scan.stereotype.DeclaredName, scan.types, scan.constructors)
+	},
+	helpers.CheckPointerMarker: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		fullPackage := scan.options.MarkerPackage(scan.stereotype.DeclaredName, scan.stereotype.FullPackage)
+
+		return helpers.FindPointerMarkersInWalk(scan.walk, helpers.CheckPointerMarker, fullPackage, scan.stereotype.MarkerField, scan.stereotype.DeclaredName)
+	},
+	helpers.CheckMarkerNotLast: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		fullPackage := scan.options.MarkerPackage(scan.stereotype.DeclaredName, scan.stereotype.FullPackage)
+
+		return helpers.FindMisplacedMarkers(scan.walk, helpers.CheckMarkerNotLast, fullPackage, scan.stereotype.MarkerField, scan.stereotype.DeclaredName)
+	},
+	helpers.CheckShadowedMarkerName: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindShadowedMarkerNames(scan.walk, helpers.CheckShadowedMarkerName, scan.stereotype.DeclaredName, scan.stereotype.MarkerField, scan.types, scan.stereotype.DeclaredName)
+	},
+	helpers.CheckMarkerMisuse: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		fullPackage := scan.options.MarkerPackage(scan.stereotype.DeclaredName, scan.stereotype.FullPackage)
+
+		return helpers.FindMarkerMisuses(scan.walk, helpers.CheckMarkerMisuse, fullPackage, scan.stereotype.MarkerField, scan.stereotype.DeclaredName)
+	},
+	helpers.CheckAggregateExternalMutation: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindExternalMutations(scan.walk, helpers.CheckAggregateExternalMutation, scan.stereotype.DeclaredName, scan.types, scan.constructors)
+	},
+	helpers.CheckAggregateExposesCollection: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		isAggregateTypeDeclaration := scan.options.TypeDeclaration(aggregate.FullPackage, aggregate.MarkerField, aggregate.DeclaredName)
+		isEntityTypeDeclaration := scan.options.TypeDeclaration(entity.FullPackage, entity.MarkerField, entity.DeclaredName)
+
+		elementTypes := make(map[string]bool)
+
+		for _, isTypeDeclaration := range []helpers.IsTypeDeclaration{isAggregateTypeDeclaration, isEntityTypeDeclaration} {
+			types, err := helpers.FindTypeDeclarationsInWalk(scan.walk, isTypeDeclaration)
+			if err != nil {
+				return nil, ge.Pin(err)
+			}
+
+			for typeName := range types {
+				elementTypes[typeName] = true
+			}
+		}
+
+		return helpers.FindExposedCollections(scan.walk, helpers.CheckAggregateExposesCollection, scan.stereotype.DeclaredName, scan.types, elementTypes)
+	},
+	helpers.CheckAggregateWithoutRepository: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		isRepositoryTypeDeclaration := scan.options.TypeDeclaration(repository.FullPackage, repository.MarkerField, repository.DeclaredName)
+
+		repositoryTypes, err := helpers.FindTypeDeclarationsInWalk(scan.walk, isRepositoryTypeDeclaration)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		return helpers.FindUnreferencedTypes(scan.walk, helpers.CheckAggregateWithoutRepository, scan.stereotype.DeclaredName, repository.DeclaredName, scan.locations, repositoryTypes)
+	},
+	helpers.CheckAggregateRootUnreachable: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		isCommandTypeDeclaration := scan.options.TypeDeclaration(commands.FullPackage, commands.MarkerField, commands.DeclaredName)
+
+		commandTypes, err := helpers.FindTypeDeclarationsInWalk(scan.walk, isCommandTypeDeclaration)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		handlers, err := helpers.FindHandlers(scan.walk, commandTypes)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		return helpers.FindUnhandledTypes(scan.walk, helpers.CheckAggregateRootUnreachable, scan.stereotype.DeclaredName, commands.DeclaredName, scan.locations, handlers, scan.constructors)
+	},
+	helpers.CheckReflectiveConstruction: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindReflectiveConstructions(scan.walk, helpers.CheckReflectiveConstruction, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckStereotypeInMain: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindStereotypesInMain(helpers.CheckStereotypeInMain, scan.stereotype.DeclaredName, scan.locations), nil
+	},
+	helpers.CheckLocalStereotypeDeclaration: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindLocalTypeDeclarations(scan.walk, helpers.CheckLocalStereotypeDeclaration, scan.stereotype.DeclaredName, scan.isTypeDeclaration)
+	},
+	helpers.CheckUnusedMarkerImport: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		fullPackage := scan.options.MarkerPackage(scan.stereotype.DeclaredName, scan.stereotype.FullPackage)
+
+		return helpers.FindUnusedMarkerImports(scan.walk, helpers.CheckUnusedMarkerImport, scan.stereotype.DeclaredName, fullPackage, []string{scan.stereotype.DeclaredName}, scan.isTypeDeclaration)
+	},
+	helpers.CheckNoConstructor: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindTypesWithoutConstructors(helpers.CheckNoConstructor, scan.stereotype.DeclaredName, scan.locations, scan.constructors), nil
+	},
+	helpers.CheckUnexportedOnlyConstructor: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindUnexportedOnlyConstructors(scan.walk, helpers.CheckUnexportedOnlyConstructor, scan.stereotype.DeclaredName, scan.locations, scan.constructors)
+	},
+	helpers.CheckStereotypeEmbedding: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindEmbeddedTypes(scan.walk, helpers.CheckStereotypeEmbedding, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckStereotypeConcurrencyField: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindConcurrencyFields(scan.walk, helpers.CheckStereotypeConcurrencyField, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckStereotypeImplementsError: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindErrorImplementations(scan.walk, helpers.CheckStereotypeImplementsError, scan.stereotype.DeclaredName, scan.types, scan.options.ErrorTypes)
+	},
+	helpers.CheckConstructorOnlyInTests: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindTestOnlyConstructors(scan.options.RootPath, helpers.CheckConstructorOnlyInTests, scan.stereotype.DeclaredName, scan.locations, scan.constructors)
+	},
+	helpers.CheckUnusedExportedStereotype: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindUnusedExportedTypes(scan.walk, helpers.CheckUnusedExportedStereotype, scan.stereotype.DeclaredName, scan.locations, scan.constructors)
+	},
+	helpers.CheckTrivialConstructor: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindTrivialConstructors(scan.walk, helpers.CheckTrivialConstructor, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckConstructorIgnoresError: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindIgnoredErrors(scan.walk, helpers.CheckConstructorIgnoresError, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckEmptyValueObject: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindEmptyTypeDeclarationsInWalk(scan.walk, helpers.CheckEmptyValueObject, scan.stereotype.DeclaredName, scan.stereotype.MarkerField, scan.isTypeDeclaration)
+	},
+	helpers.CheckEmptyCommand: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindEmptyTypeDeclarationsInWalk(scan.walk, helpers.CheckEmptyCommand, scan.stereotype.DeclaredName, scan.stereotype.MarkerField, scan.isTypeDeclaration)
+	},
+	helpers.CheckIncompleteConstruction: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		typeFields, err := helpers.FindTypeFieldsInWalk(scan.walk, scan.types, scan.stereotype.MarkerField)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		return helpers.FindIncompleteConstructionsInWalk(scan.walk, helpers.CheckIncompleteConstruction, scan.stereotype.DeclaredName, typeFields)
+	},
+	helpers.CheckIncompleteEquals: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		typeFields, err := helpers.FindTypeFieldsInWalk(scan.walk, scan.types, scan.stereotype.MarkerField)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		return helpers.FindIncompleteEquals(scan.walk, helpers.CheckIncompleteEquals, scan.stereotype.DeclaredName, typeFields)
+	},
+	helpers.CheckLargeValueObject: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		if scan.options.MaxValueObjectFields <= 0 {
+			return nil, nil
+		}
+
+		typeFields, err := helpers.FindTypeFieldsInWalk(scan.walk, scan.types, scan.stereotype.MarkerField)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		return helpers.FindLargeTypes(helpers.CheckLargeValueObject, scan.stereotype.DeclaredName, scan.options.MaxValueObjectFields, scan.locations, typeFields), nil
+	},
+	helpers.CheckValueObjectFieldTags: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindTaggedFields(scan.walk, helpers.CheckValueObjectFieldTags, scan.stereotype.DeclaredName, scan.stereotype.MarkerField, scan.types)
+	},
+	helpers.CheckNondeterministicValueObject: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindNondeterministicCalls(scan.walk, helpers.CheckNondeterministicValueObject, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckValueObjectPointerStringer: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindPointerStringers(scan.walk, helpers.CheckValueObjectPointerStringer, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckValueObjectNonValueField: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindNonValueFields(scan.walk, helpers.CheckValueObjectNonValueField, scan.stereotype.DeclaredName, scan.stereotype.MarkerField, scan.types, scan.types)
+	},
+	helpers.CheckValueObjectGlobalMutation: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindGlobalMutations(scan.walk, helpers.CheckValueObjectGlobalMutation, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckEntityAsMapKey: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindMapKeyUsagesInWalk(scan.walk, helpers.CheckEntityAsMapKey, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckAggregateInternalSetter: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindExportedMutators(scan.walk, helpers.CheckAggregateInternalSetter, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckValueObjectAddressTaken: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindAddressTakenArguments(scan.walk, helpers.CheckValueObjectAddressTaken, scan.stereotype.DeclaredName, scan.types, scan.constructors)
+	},
+	helpers.CheckValueObjectDeepEqual: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindDeepEqualComparisons(scan.walk, helpers.CheckValueObjectDeepEqual, scan.stereotype.DeclaredName, scan.types, scan.constructors)
+	},
+	helpers.CheckValueObjectStoredAsPointer: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindPointerStorage(scan.walk, helpers.CheckValueObjectStoredAsPointer, scan.stereotype.DeclaredName, helpers.ValueConstructedTypes(scan.constructors))
+	},
+	helpers.CheckValueObjectMutationViaEntity: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		valueObjectTypes, pointerFields, err := valueObjectPointerFields(scan)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		if len(pointerFields) == 0 {
+			return nil, nil
+		}
+
+		valueObjectFields, err := helpers.FindTypeFieldsInWalk(scan.walk, valueObjectTypes, valueobject.MarkerField)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		return helpers.FindMutationsThroughPointerFields(scan.walk, helpers.CheckValueObjectMutationViaEntity, valueobject.DeclaredName, scan.stereotype.DeclaredName, pointerFields, valueObjectFields)
+	},
+	helpers.CheckValueObjectReplaced: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		_, pointerFields, err := valueObjectPointerFields(scan)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		if len(pointerFields) == 0 {
+			return nil, nil
+		}
+
+		return helpers.FindPointerFieldReplacements(scan.walk, helpers.CheckValueObjectReplaced, valueobject.DeclaredName, scan.stereotype.DeclaredName, pointerFields)
+	},
+	helpers.CheckPossibleNilValueObject: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		_, pointerFields, err := valueObjectPointerFields(scan)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		if len(pointerFields) == 0 {
+			return nil, nil
+		}
+
+		return helpers.FindUnguardedPointerDereferences(scan.walk, helpers.CheckPossibleNilValueObject, scan.stereotype.DeclaredName, valueobject.DeclaredName, pointerFields)
+	},
+	helpers.CheckAnemicEntity: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindAnemicTypes(scan.walk, helpers.CheckAnemicEntity, scan.stereotype.DeclaredName, scan.locations)
+	},
+	helpers.CheckCommandWithBehavior: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		return helpers.FindBehaviorMethods(scan.walk, helpers.CheckCommandWithBehavior, scan.stereotype.DeclaredName, scan.types)
+	},
+	helpers.CheckCommandQueryConflict: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		isQueryTypeDeclaration := scan.options.TypeDeclaration(queries.FullPackage, queries.MarkerField, queries.DeclaredName)
+
+		queryTypes, err := helpers.FindTypeDeclarationsInWalk(scan.walk, isQueryTypeDeclaration)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		return helpers.FindConflictingMarkers(helpers.CheckCommandQueryConflict, scan.stereotype.DeclaredName, queries.DeclaredName, scan.locations, queryTypes), nil
+	},
+	helpers.CheckCrossContextCommand: func(scan *stereotypeScan) (map[string]*helpers.Violation, error) {
+		handlers, err := helpers.FindHandlers(scan.walk, scan.types)
+		if err != nil {
+			return nil, ge.Pin(err)
+		}
+
+		return helpers.FindCrossContextHandlers(helpers.CheckCrossContextCommand, scan.stereotype.DeclaredName, scan.options.BoundedContextRoot(), scan.locations, handlers), nil
+	},
+}
+
+// valueObjectPointerFields collects the Value Object types and the fields of the scanned types pointing to them.
+func valueObjectPointerFields(scan *stereotypeScan) (map[string]bool, map[string][]*helpers.PointerField, error) {
+	isValueObjectTypeDeclaration := scan.options.TypeDeclaration(valueobject.FullPackage, valueobject.MarkerField, valueobject.DeclaredName)
+
+	valueObjectTypes, err := helpers.FindTypeDeclarationsInWalk(scan.walk, isValueObjectTypeDeclaration)
+	if err != nil {
+		return nil, nil, ge.Pin(err)
+	}
+
+	pointerFields, err := helpers.FindPointerFields(scan.walk, scan.types, valueObjectTypes)
+	if err != nil {
+		return nil, nil, ge.Pin(err)
+	}
+
+	return valueObjectTypes, pointerFields, nil
+}
+
+var (
+	registryMutex sync.RWMutex
+	registry      = make(map[string]Stereotype)
+)
+
+func init() {
+	builtins := []Stereotype{
+		{
+			Name:         valueobject.DeclaredName,
+			FullPackage:  valueobject.FullPackage,
+			PackageName:  valueobject.PackageName,
+			DeclaredName: valueobject.DeclaredName,
+			MarkerField:  valueobject.MarkerField,
+			Checks: append([]string{
+				helpers.CheckEmptyValueObject,
+				helpers.CheckIncompleteConstruction,
+				helpers.CheckValueObjectFieldTags,
+				helpers.CheckNondeterministicValueObject,
+				helpers.CheckValueObjectPointerStringer,
+				helpers.CheckValueObjectNonValueField,
+				helpers.CheckValueObjectGlobalMutation,
+				helpers.CheckValueObjectAddressTaken,
+				helpers.CheckValueObjectDeepEqual,
+				helpers.CheckValueObjectStoredAsPointer,
+				helpers.CheckIncompleteEquals,
+				helpers.CheckLargeValueObject,
+			}, DefaultChecks...),
+		},
+		{
+			Name:         entity.DeclaredName,
+			FullPackage:  entity.FullPackage,
+			DeclaredName: entity.DeclaredName,
+			MarkerField:  entity.MarkerField,
+			Checks: append([]string{
+				helpers.CheckEntityAsMapKey,
+				helpers.CheckValueObjectMutationViaEntity,
+				helpers.CheckValueObjectReplaced,
+				helpers.CheckPossibleNilValueObject,
+				helpers.CheckAnemicEntity,
+			}, DefaultChecks...),
+		},
+		{
+			Name:         aggregate.DeclaredName,
+			FullPackage:  aggregate.FullPackage,
+			DeclaredName: aggregate.DeclaredName,
+			MarkerField:  aggregate.MarkerField,
+			Checks:       append([]string{helpers.CheckAggregateInternalSetter}, DefaultChecks...),
+		},
+		{
+			Name:         aggregate.DeclaredRootName,
+			FullPackage:  aggregate.FullPackage,
+			DeclaredName: aggregate.DeclaredRootName,
+			MarkerField:  aggregate.MarkerField,
+			Checks: append([]string{
+				helpers.CheckAggregateExposesCollection,
+				helpers.CheckAggregateExternalMutation,
+				helpers.CheckAggregateWithoutRepository,
+				helpers.CheckAggregateRootUnreachable,
+			}, DefaultChecks...),
+		},
+		{
+			Name:         commands.DeclaredName,
+			FullPackage:  commands.FullPackage,
+			DeclaredName: commands.DeclaredName,
+			MarkerField:  commands.MarkerField,
+			Checks: append([]string{
+				helpers.CheckEmptyCommand,
+				helpers.CheckCommandQueryConflict,
+				helpers.CheckCrossContextCommand,
+				helpers.CheckCommandWithBehavior,
+			}, DefaultChecks...),
+		},
+		{
+			Name:         queries.DeclaredName,
+			FullPackage:  queries.FullPackage,
+			DeclaredName: queries.DeclaredName,
+			MarkerField:  queries.MarkerField,
+		},
+	}
+
+	for _, stereotype := range builtins {
+		if err := RegisterStereotype(stereotype); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterStereotype adds a stereotype to the ones validated by ValidateRegistered.
+// The built-in stereotypes are registered by default.
+//
+// Parameters:
+//   - stereotype: The stereotype definition, MarkerField defaults to "_"
+//
+// Returns:
+//   - An error wrapping ErrInvalidStereotype if a required field is empty,
+//     ErrUnknownCheck if a check cannot be run generically,
+//     ErrStereotypeExists if the name is taken, nil otherwise
+func RegisterStereotype(stereotype Stereotype) error {
+	if stereotype.Name == "" || stereotype.FullPackage == "" || stereotype.DeclaredName == "" {
+		return ge.Pin(ErrInvalidStereotype, ge.Params{"name": stereotype.Name})
+	}
+
+	if stereotype.MarkerField == "" {
+		stereotype.MarkerField = "_"
+	}
+
+	if stereotype.Checks == nil {
+		stereotype.Checks = DefaultChecks
+	}
+
+	for _, check := range stereotype.Checks {
+		if _, ok := checkRunners[check]; !ok {
+			return ge.Pin(ErrUnknownCheck, ge.Params{"name": stereotype.Name, "check": check})
+		}
+	}
+
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if _, ok := registry[stereotype.Name]; ok {
+		return ge.Pin(ErrStereotypeExists, ge.Params{"name": stereotype.Name})
+	}
+
+	registry[stereotype.Name] = stereotype
+
+	if stereotype.PackageName != "" {
+		helpers.RegisterPackageName(stereotype.FullPackage, stereotype.PackageName)
+	}
+
+	return nil
+}
+
+// RegisteredStereotypes returns the registered stereotypes sorted by name.
+func RegisteredStereotypes() []Stereotype {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	stereotypes := make([]Stereotype, 0, len(registry))
+	for _, stereotype := range registry {
+		stereotypes = append(stereotypes, stereotype)
+	}
+
+	sort.Slice(stereotypes, func(i, j int) bool {
+		return stereotypes[i].Name < stereotypes[j].Name
+	})
+
+	return stereotypes
+}
+
+// ValidateRegistered runs every registered stereotype over rootPath, sharing one parse of the project.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - opts: Optional settings such as helpers.WithMinSeverity
+//
+// Returns:
+//   - map[string]*helpers.Report: Reports keyed by stereotype name, stereotypes without types are omitted
+//   - error: An error if the validation process fails, nil otherwise
+func ValidateRegistered(rootPath string, opts ...helpers.Option) (map[string]*helpers.Report, error) {
+	options, err := helpers.LoadOptions(rootPath, opts...)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	walk := options.Walker(rootPath)
+	reports := make(map[string]*helpers.Report)
+
+	for _, stereotype := range RegisteredStereotypes() {
+		report, err := validateStereotype(walk, options, stereotype)
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"stereotype": stereotype.Name})
+		}
+
+		if report != nil {
+			reports[stereotype.Name] = report
+		}
+	}
+
+	return reports, nil
+}
+
+// ValidateAmbiguousConstructors reports constructors discovered for the types of more than one registered stereotype,
+// such as a struct that carries two markers by accident, listing the conflicting stereotypes.
+// The stereotype reports attribute such constructors to every stereotype, this report names the root misconfiguration.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - opts: Optional settings such as helpers.WithMinSeverity, overriding the project configuration file
+//
+// Returns:
+//   - *helpers.Report: The report, its Types and Constructors list the ambiguously classified types and their constructors
+//   - error: An error if the validation process fails, nil otherwise
+func ValidateAmbiguousConstructors(rootPath string, opts ...helpers.Option) (*helpers.Report, error) {
+	options, err := helpers.LoadOptions(rootPath, opts...)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	walk := options.Walker(rootPath)
+	constructors := make(map[string]map[string]*helpers.ConstructorInfo)
+
+	for _, stereotype := range RegisteredStereotypes() {
+		isTypeDeclaration := options.TypeDeclaration(stereotype.FullPackage, stereotype.MarkerField, stereotype.DeclaredName, stereotype.AlternativeMarkers...)
+
+		stopTypeDiscovery := options.Metrics.Track(helpers.PhaseTypeDiscovery)
+		types, err := helpers.FindTypeDeclarationsInWalk(walk, isTypeDeclaration)
+		stopTypeDiscovery()
+
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"stereotype": stereotype.Name})
+		}
+
+		stopConstructorDiscovery := options.Metrics.Track(helpers.PhaseConstructorDiscovery)
+		constructors[stereotype.Name], err = helpers.FindConstructorsWithFactories(walk, options.FilterTypes(types), options.InterfaceFactories)
+		stopConstructorDiscovery()
+
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"stereotype": stereotype.Name})
+		}
+	}
+
+	stopViolationDetection := options.Metrics.Track(helpers.PhaseViolationDetection)
+	violations := helpers.FindAmbiguousConstructors(helpers.CheckAmbiguousConstructor, constructors)
+	stopViolationDetection()
+
+	types := make(map[string]bool)
+	ambiguous := make(map[string]*helpers.ConstructorInfo)
+
+	for _, violation := range violations {
+		types[violation.TypeName] = true
+	}
+
+	for _, stereotypeConstructors := range constructors {
+		for key, constructor := range stereotypeConstructors {
+			if types[constructor.TypeName] {
+				ambiguous[key] = constructor
+			}
+		}
+	}
+
+	return helpers.NewReport(types, ambiguous, violations, options), nil
+}
+
+// ScaffoldConstructors generates a constructor stub for every type of a registered stereotype without a constructor,
+// see helpers.ScaffoldConstructors, turning no-constructor findings into code to start from.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - opts: Optional settings such as helpers.WithTypeNames, overriding the project configuration file
+//
+// Returns:
+//   - []*helpers.ConstructorStub: The stubs sorted by file and type name
+//   - error: An error if the scan fails, nil otherwise
+func ScaffoldConstructors(rootPath string, opts ...helpers.Option) ([]*helpers.ConstructorStub, error) {
+	options, err := helpers.LoadOptions(rootPath, opts...)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	walk := options.Walker(rootPath)
+
+	var stubs []*helpers.ConstructorStub
+
+	for _, stereotype := range RegisteredStereotypes() {
+		isTypeDeclaration := options.TypeDeclaration(stereotype.FullPackage, stereotype.MarkerField, stereotype.DeclaredName, stereotype.AlternativeMarkers...)
+
+		types, err := helpers.FindTypeDeclarationsInWalk(walk, isTypeDeclaration)
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"stereotype": stereotype.Name})
+		}
+
+		types = options.FilterTypes(types)
+
+		constructors, err := helpers.FindConstructorsWithFactories(walk, types, options.InterfaceFactories)
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"stereotype": stereotype.Name})
+		}
+
+		stereotypeStubs, err := helpers.ScaffoldConstructors(walk, types, constructors, stereotype.MarkerField)
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"stereotype": stereotype.Name})
+		}
+
+		stubs = append(stubs, stereotypeStubs...)
+	}
+
+	sort.SliceStable(stubs, func(i, j int) bool {
+		if stubs[i].File != stubs[j].File {
+			return stubs[i].File < stubs[j].File
+		}
+
+		return stubs[i].TypeName < stubs[j].TypeName
+	})
+
+	return stubs, nil
+}
+
+// validateStereotype runs the checks of a stereotype, returning nil if it has no types.
+func validateStereotype(walk helpers.Walker, options *helpers.Options, stereotype Stereotype) (*helpers.Report, error) {
+	scan := &stereotypeScan{
+		walk:              walk,
+		options:           options,
+		stereotype:        stereotype,
+		isTypeDeclaration: options.TypeDeclaration(stereotype.FullPackage, stereotype.MarkerField, stereotype.DeclaredName, stereotype.AlternativeMarkers...),
+	}
+
+	// Projects never importing the marker package have nothing to validate, skip parsing them
+	hasMarkers, err := options.ImportsAnyMarker(options.RootPath, options.Markers(stereotype.DeclaredName, stereotype.FullPackage, stereotype.AlternativeMarkers...))
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	if !hasMarkers {
+		return nil, nil
+	}
+
+	stopTypeDiscovery := options.Metrics.Track(helpers.PhaseTypeDiscovery)
+	types, hookViolations, err := helpers.FindTypeDeclarationsWithChecks(walk, stereotype.DeclaredName, scan.isTypeDeclaration, options.TypeChecks)
+	stopTypeDiscovery()
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	scan.types = options.FilterTypes(types)
+
+	if len(scan.types) == 0 {
+		return nil, nil
+	}
+
+	scan.locations, err = helpers.FindTypeLocations(walk, scan.types)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopConstructorDiscovery := options.Metrics.Track(helpers.PhaseConstructorDiscovery)
+	scan.constructors, err = helpers.FindConstructorsWithFactories(walk, scan.types, options.InterfaceFactories)
+	stopConstructorDiscovery()
+
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	stopViolationDetection := options.Metrics.Track(helpers.PhaseViolationDetection)
+
+	violations := hookViolations
+
+	for _, check := range stereotype.Checks {
+		if !options.IsCheckEnabled(check) {
+			continue
+		}
+
+		checkViolations, err := checkRunners[check](scan)
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"check": check})
+		}
+
+		helpers.MergeViolations(violations, checkViolations)
+	}
+
+	stopViolationDetection()
+
+	return helpers.NewReport(scan.types, scan.constructors, violations, options), nil
+}
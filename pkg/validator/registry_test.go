@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+)
+
+// reportedChecks returns the sorted identifiers of the checks violated in any of the reports.
+func reportedChecks(reports map[string]*helpers.Report) []string {
+	seen := make(map[string]bool)
+
+	for _, report := range reports {
+		for _, violation := range report.Violations {
+			seen[violation.Check] = true
+		}
+	}
+
+	checks := make([]string, 0, len(seen))
+	for check := range seen {
+		checks = append(checks, check)
+	}
+
+	sort.Strings(checks)
+
+	return checks
+}
+
+func TestValidateRegisteredRunsEveryPipelineCheck(t *testing.T) {
+	const rootPath = "testdata/registered"
+
+	opts := []helpers.Option{helpers.WithEnabledChecks(helpers.CheckPossibleNilValueObject)}
+
+	reports, err := Validate(rootPath, opts...)
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	registeredReports, err := ValidateRegistered(rootPath, opts...)
+	if err != nil {
+		t.Fatalf("ValidateRegistered() error = %v", err)
+	}
+
+	registered := make(map[string]bool)
+	for _, check := range reportedChecks(registeredReports) {
+		registered[check] = true
+	}
+
+	for _, check := range []string{
+		helpers.CheckAggregateExposesCollection,
+		helpers.CheckCommandQueryConflict,
+		helpers.CheckPossibleNilValueObject,
+		helpers.CheckValueObjectReplaced,
+		helpers.CheckValueObjectMutationViaEntity,
+	} {
+		if !registered[check] {
+			t.Errorf("ValidateRegistered() does not report %s", check)
+		}
+	}
+
+	for _, check := range reportedChecks(reports) {
+		if !registered[check] {
+			t.Errorf("Validate() reports %s, ValidateRegistered() does not", check)
+		}
+	}
+}
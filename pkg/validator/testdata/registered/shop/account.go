@@ -0,0 +1,35 @@
+package shop
+
+import (
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/entity"
+)
+
+// Account holds its Money through a pointer.
+type Account struct {
+	id    string
+	money *Money
+
+	_ entity.Entity
+}
+
+// NewAccount returns a new Account.
+func NewAccount(id string) *Account {
+	money := NewMoney(0)
+
+	return &Account{id: id, money: &money}
+}
+
+// Deposit changes the Money the Account points to.
+func (a *Account) Deposit(amount int) {
+	a.money.amount += amount
+}
+
+// Reset replaces the Money of the Account.
+func (a *Account) Reset() {
+	a.money = &Money{}
+}
+
+// Balance dereferences the Money without a nil check.
+func (a *Account) Balance() int {
+	return a.money.amount
+}
@@ -0,0 +1,17 @@
+package shop
+
+import (
+	valueobject "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+)
+
+// Money is a Value Object.
+type Money struct {
+	amount int
+
+	_ valueobject.ValueObject
+}
+
+// NewMoney returns a new Money.
+func NewMoney(amount int) Money {
+	return Money{amount: amount}
+}
@@ -0,0 +1,35 @@
+package shop
+
+import (
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/aggregate"
+)
+
+// Line is an internal Aggregate of Order.
+type Line struct {
+	quantity int
+
+	_ aggregate.Aggregate
+}
+
+// NewLine returns a new Line.
+func NewLine(quantity int) *Line {
+	return &Line{quantity: quantity}
+}
+
+// Order is an AggregateRoot exposing its lines.
+type Order struct {
+	id    string
+	lines []*Line
+
+	_ aggregate.AggregateRoot
+}
+
+// NewOrder returns a new Order.
+func NewOrder(id string) *Order {
+	return &Order{id: id}
+}
+
+// Lines hands out the internal lines.
+func (o *Order) Lines() []*Line {
+	return o.lines
+}
@@ -0,0 +1,19 @@
+package shop
+
+import (
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/objects/commands"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/objects/queries"
+)
+
+// Transfer is marked both as a Command and as a Query.
+type Transfer struct {
+	amount int
+
+	_ commands.Command
+	_ queries.Query
+}
+
+// NewTransfer returns a new Transfer.
+func NewTransfer(amount int) Transfer {
+	return Transfer{amount: amount}
+}
@@ -0,0 +1,23 @@
+package geo
+
+import (
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+)
+
+// Location imports the marker package without an alias.
+type Location struct {
+	x int
+	y int
+
+	_ valueobject.ValueObject
+}
+
+// NewLocation returns a new Location.
+func NewLocation(x, y int) Location {
+	return Location{x: x, y: y}
+}
+
+// Origin bypasses the constructor.
+func Origin() Location {
+	return Location{}
+}
@@ -0,0 +1,259 @@
+// Package validator runs the validators of every supported stereotype together.
+package validator
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/aggregate"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/entity"
+	valueobject "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/objects/commands"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/objects/queries"
+	"github.com/nobuenhombre/suikat/pkg/ge"
+)
+
+// ValidateFunc runs the validation of a single stereotype and returns its report.
+type ValidateFunc func(rootPath string, opts ...helpers.Option) (*helpers.Report, error)
+
+// Validators lists the validator of every supported stereotype by its marker name.
+var Validators = map[string]ValidateFunc{
+	valueobject.DeclaredName: func(rootPath string, opts ...helpers.Option) (*helpers.Report, error) {
+		report, err := valueobject.ValidateValueObjects(rootPath, opts...)
+		if err != nil || report == nil {
+			return nil, err
+		}
+
+		return &report.Report, nil
+	},
+	entity.DeclaredName: func(rootPath string, opts ...helpers.Option) (*helpers.Report, error) {
+		report, err := entity.ValidateEntities(rootPath, opts...)
+		if err != nil || report == nil {
+			return nil, err
+		}
+
+		return &report.Report, nil
+	},
+	aggregate.DeclaredName: func(rootPath string, opts ...helpers.Option) (*helpers.Report, error) {
+		report, err := aggregate.ValidateAggregates(rootPath, opts...)
+		if err != nil || report == nil {
+			return nil, err
+		}
+
+		return &report.Report, nil
+	},
+	commands.DeclaredName: func(rootPath string, opts ...helpers.Option) (*helpers.Report, error) {
+		report, err := commands.ValidateCommands(rootPath, opts...)
+		if err != nil || report == nil {
+			return nil, err
+		}
+
+		return &report.Report, nil
+	},
+	queries.DeclaredName: func(rootPath string, opts ...helpers.Option) (*helpers.Report, error) {
+		report, err := queries.ValidateQueries(rootPath, opts...)
+		if err != nil || report == nil {
+			return nil, err
+		}
+
+		return &report.Report, nil
+	},
+}
+
+// Validate runs the validator of every supported stereotype.
+// The validators share one parse of rootPath, see helpers.WithSharedParse,
+// so progress given with helpers.WithProgress is reported once.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - opts: Optional settings such as helpers.WithMinSeverity
+//
+// Returns:
+//   - map[string]*helpers.Report: Reports keyed by stereotype marker name, stereotypes without types are omitted
+//   - error: An error if the validation process fails, nil otherwise
+func Validate(rootPath string, opts ...helpers.Option) (map[string]*helpers.Report, error) {
+	sharedOpts := append([]helpers.Option{}, opts...)
+	sharedOpts = append(sharedOpts, helpers.WithSharedParse(helpers.NewSharedParse()))
+
+	reports := make(map[string]*helpers.Report)
+
+	for declaredName, validate := range Validators {
+		report, err := validate(rootPath, sharedOpts...)
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"stereotype": declaredName})
+		}
+
+		if report != nil {
+			reports[declaredName] = report
+		}
+	}
+
+	return reports, nil
+}
+
+// ValidateType validates a single type, whatever stereotype it is marked with.
+// A type marked with several stereotypes, e.g. as a Command and a Query, gets the reports of all of them merged,
+// see helpers.MergeReports.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - fullTypeName: The type name in format "package.TypeName", e.g. "location.Location",
+//     or a report type key qualified with the import path, e.g. "github.com/acme/shop/location.Location"
+//   - opts: Optional settings such as helpers.WithMinSeverity
+//
+// Returns:
+//   - *helpers.Report: The report restricted to the type
+//   - error: An error if the validation process fails, nil otherwise
+//
+// Returns nil if the type is not declared with any known stereotype marker.
+func ValidateType(rootPath string, fullTypeName string, opts ...helpers.Option) (*helpers.Report, error) {
+	typeOpts := append([]helpers.Option{}, opts...)
+	typeOpts = append(typeOpts, helpers.WithTypeNames(fullTypeName), helpers.WithSharedParse(helpers.NewSharedParse()))
+
+	declaredNames := make([]string, 0, len(Validators))
+	for declaredName := range Validators {
+		declaredNames = append(declaredNames, declaredName)
+	}
+
+	sort.Strings(declaredNames)
+
+	var reports []*helpers.Report
+
+	for _, declaredName := range declaredNames {
+		report, err := Validators[declaredName](rootPath, typeOpts...)
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"stereotype": declaredName})
+		}
+
+		if report != nil {
+			reports = append(reports, report)
+		}
+	}
+
+	switch len(reports) {
+	case 0:
+		return nil, nil
+	case 1:
+		return reports[0], nil
+	default:
+		return helpers.MergeReports(reports...), nil
+	}
+}
+
+// ValidateDiff runs the validator of every supported stereotype over the whole tree,
+// so constructors and constructor scope are resolved as usual, but only reports violations
+// on the added lines of a diff, keeping pull request feedback to the changed code.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - addedLines: Added line numbers per file path relative to rootPath, e.g. taken from a unified diff
+//   - opts: Optional settings such as helpers.WithMinSeverity
+//
+// Returns:
+//   - map[string]*helpers.Report: Reports keyed by stereotype marker name, stereotypes without types are omitted
+//   - error: An error if the validation process fails, nil otherwise
+func ValidateDiff(rootPath string, addedLines map[string][]int, opts ...helpers.Option) (map[string]*helpers.Report, error) {
+	diffOpts := append([]helpers.Option{}, opts...)
+	diffOpts = append(diffOpts, helpers.WithAddedLines(addedLines))
+
+	return Validate(rootPath, diffOpts...)
+}
+
+// ValidateReachable runs the validator of every supported stereotype over the packages an entrypoint package
+// imports, directly or transitively within its module, scoping validation to one bounded context
+// without listing its paths, see helpers.WithEntrypoint.
+//
+// Parameters:
+//   - rootPath: The root directory path to scan for Go source files
+//   - entrypoint: The import path of the entrypoint package, e.g. "example.com/shop/ordering"
+//   - opts: Optional settings such as helpers.WithMinSeverity
+//
+// Returns:
+//   - map[string]*helpers.Report: Reports keyed by stereotype marker name, stereotypes without types are omitted
+//   - error: An error wrapping helpers.ErrEntrypointNotFound if the entrypoint is not under rootPath, nil otherwise
+func ValidateReachable(rootPath string, entrypoint string, opts ...helpers.Option) (map[string]*helpers.Report, error) {
+	reachableOpts := append([]helpers.Option{}, opts...)
+	reachableOpts = append(reachableOpts, helpers.WithEntrypoint(entrypoint))
+
+	return Validate(rootPath, reachableOpts...)
+}
+
+// ValidateRoots runs the validator of every supported stereotype over several independent roots
+// and merges the results into one report per stereotype.
+// Every root is scanned on its own, so constructors are only matched within the same root.
+// File paths are rendered relative to the common parent directory of the roots,
+// keeping the root of every violation visible, unless helpers.WithRelativeTo is given.
+//
+// Parameters:
+//   - roots: The root directory paths to scan for Go source files
+//   - opts: Optional settings such as helpers.WithMinSeverity
+//
+// Returns:
+//   - map[string]*helpers.Report: Merged reports keyed by stereotype marker name, stereotypes without types are omitted
+//   - error: An error if the validation of any root fails, nil otherwise
+func ValidateRoots(roots []string, opts ...helpers.Option) (map[string]*helpers.Report, error) {
+	base, err := commonDir(roots)
+	if err != nil {
+		return nil, ge.Pin(err)
+	}
+
+	rootOpts := append([]helpers.Option{helpers.WithRelativeTo(base)}, opts...)
+	merged := make(map[string]*helpers.Report)
+
+	for _, root := range roots {
+		reports, err := Validate(root, rootOpts...)
+		if err != nil {
+			return nil, ge.Pin(err, ge.Params{"root": root})
+		}
+
+		for declaredName, report := range reports {
+			if existing, ok := merged[declaredName]; ok {
+				existing.Merge(report)
+				continue
+			}
+
+			merged[declaredName] = report
+		}
+	}
+
+	return merged, nil
+}
+
+// commonDir returns the deepest directory containing every path.
+func commonDir(paths []string) (string, error) {
+	var common []string
+
+	for i, path := range paths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return "", ge.Pin(err, ge.Params{"path": path})
+		}
+
+		parts := strings.Split(filepath.ToSlash(absPath), "/")
+
+		if i == 0 {
+			common = parts
+			continue
+		}
+
+		n := 0
+		for n < len(common) && n < len(parts) && common[n] == parts[n] {
+			n++
+		}
+
+		common = common[:n]
+	}
+
+	if len(common) == 0 {
+		return "", nil
+	}
+
+	dir := strings.Join(common, "/")
+	if dir == "" {
+		dir = "/"
+	}
+
+	return filepath.FromSlash(dir), nil
+}
@@ -0,0 +1,90 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nobuenhombre/dddgo/pkg/helpers"
+	valueobject "github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/domain/objects/value-object"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/objects/commands"
+	"github.com/nobuenhombre/dddgo/pkg/layers/infrastructure/interface-adapters/application/objects/queries"
+)
+
+func TestValidateTypeMergesEveryMatchingStereotype(t *testing.T) {
+	const (
+		rootPath = "testdata/registered"
+		typeName = "shop.Transfer"
+	)
+
+	var stereotypeReports []*helpers.Report
+
+	for _, declaredName := range []string{commands.DeclaredName, queries.DeclaredName} {
+		report, err := Validators[declaredName](rootPath, helpers.WithTypeNames(typeName))
+		if err != nil {
+			t.Fatalf("%s validator error = %v", declaredName, err)
+		}
+
+		if report == nil {
+			t.Fatalf("%s validator does not match %s", declaredName, typeName)
+		}
+
+		stereotypeReports = append(stereotypeReports, report)
+	}
+
+	want := helpers.MergeReports(stereotypeReports...)
+
+	for i := 0; i < 10; i++ {
+		got, err := ValidateType(rootPath, typeName)
+		if err != nil {
+			t.Fatalf("ValidateType() error = %v", err)
+		}
+
+		if !reflect.DeepEqual(got.Violations, want.Violations) {
+			t.Fatalf("ValidateType() violations = %v, want %v", got.Violations, want.Violations)
+		}
+	}
+}
+
+func TestValidateUnaliasedHyphenatedMarkerImport(t *testing.T) {
+	report, err := Validators[valueobject.DeclaredName]("testdata/unaliased")
+	if err != nil {
+		t.Fatalf("ValueObject validator error = %v", err)
+	}
+
+	if report == nil {
+		t.Fatal("ValueObject validator does not detect geo.Location")
+	}
+
+	if got := reportedChecks(map[string]*helpers.Report{valueobject.DeclaredName: report}); !reflect.DeepEqual(got, []string{helpers.CheckZeroValueInitialization}) {
+		t.Errorf("reported checks = %v, want %v", got, []string{helpers.CheckZeroValueInitialization})
+	}
+}
+
+func TestValidateParsesOnce(t *testing.T) {
+	const rootPath = "testdata/registered"
+
+	var calls []int
+
+	_, err := Validate(rootPath, helpers.WithProgress(func(scanned int, total int) {
+		calls = append(calls, scanned)
+	}))
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	total, err := helpers.CountGoFiles(rootPath)
+	if err != nil {
+		t.Fatalf("helpers.CountGoFiles() error = %v", err)
+	}
+
+	// One parse reports every file once, in order, instead of starting over for every stereotype
+	if len(calls) != total {
+		t.Fatalf("progress reported %d times, want %d: %v", len(calls), total, calls)
+	}
+
+	for i, scanned := range calls {
+		if scanned != i+1 {
+			t.Fatalf("progress = %v, want 1 to %d", calls, total)
+		}
+	}
+}